@@ -0,0 +1,88 @@
+package lingua
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// caseTransformer converts the case of the value with the rules of the language,
+// like the Turkish dotted İ, the Dutch IJ and the Greek final sigma.
+type caseTransformer func(tag language.Tag) cases.Caser
+
+func upperCaser(tag language.Tag) cases.Caser {
+	return cases.Upper(tag)
+}
+
+func lowerCaser(tag language.Tag) cases.Caser {
+	return cases.Lower(tag)
+}
+
+func titleCaser(tag language.Tag) cases.Caser {
+	return cases.Title(tag)
+}
+
+func (t caseTransformer) Transform(_ context.Context, lang LanguageID, value any, _ map[string]any) any {
+	return t.format(lang.Tag(), value)
+}
+
+func (t caseTransformer) transform(f *formatter, value any) any {
+	return t.format(f.tag(), value)
+}
+
+func (t caseTransformer) format(tag language.Tag, value any) string {
+	// A Caser keeps state, so a new one is created for every value.
+	return t(tag).String(formatReplacement(value))
+}
+
+// capitalizeTransformer uppercases the first letter of the value with the rules of the language.
+// The rest of the value is not changed, so "e-mail address" becomes "E-mail address".
+type capitalizeTransformer struct{}
+
+func (t capitalizeTransformer) Transform(_ context.Context, lang LanguageID, value any, _ map[string]any) any {
+	return t.format(lang.Tag(), value)
+}
+
+func (t capitalizeTransformer) transform(f *formatter, value any) any {
+	return t.format(f.tag(), value)
+}
+
+func (capitalizeTransformer) format(tag language.Tag, value any) string {
+	s := formatReplacement(value)
+	if s == "" {
+		return s
+	}
+
+	// The first letter is the first rune with the combining marks that follow it, or the IJ in Dutch.
+	_, end := utf8.DecodeRuneInString(s)
+	if base, _ := tag.Base(); base == dutch && len(s) >= 2 && strings.EqualFold(s[:2], "ij") {
+		end = 2
+	}
+
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		if !unicode.Is(unicode.Mn, r) {
+			break
+		}
+
+		end += size
+	}
+
+	// Only the i has another upper case in some languages, like the Turkish İ, so other ASCII letters skip the Caser.
+	if end == 1 && s[0] < utf8.RuneSelf && s[0] != 'i' {
+		if s[0] < 'a' || s[0] > 'z' {
+			return s
+		}
+
+		return string(s[0]-'a'+'A') + s[1:]
+	}
+
+	// Title case keeps the accent that upper case removes in some languages, like the Greek Ό.
+	return cases.Title(tag, cases.NoLower).String(s[:end]) + s[end:]
+}
+
+var dutch = language.MustParseBase("nl")
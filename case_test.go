@@ -0,0 +1,55 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerCase(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, lang := range []string{"en", "nl", "tr", "el", "de"} {
+		mustWriteYaml(t, fs, lang+".yaml", `
+upper: ":name|upper"
+lower: ":name|lower"
+title: ":name|title"
+capitalize: ":name|capitalize"
+`)
+	}
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	cases := []struct {
+		lang   string
+		key    Key
+		value  any
+		expect string
+	}{
+		{lang: "en", key: "upper", value: "istanbul", expect: "ISTANBUL"},
+		{lang: "tr", key: "upper", value: "istanbul", expect: "İSTANBUL"},
+		{lang: "tr", key: "lower", value: "ISPARTA", expect: "ısparta"},
+		{lang: "tr", key: "capitalize", value: "istanbul", expect: "İstanbul"},
+		{lang: "en", key: "capitalize", value: "istanbul", expect: "Istanbul"},
+		{lang: "nl", key: "capitalize", value: "ijsland is mooi", expect: "IJsland is mooi"},
+		{lang: "nl", key: "title", value: "ijsland is mooi", expect: "IJsland Is Mooi"},
+		{lang: "en", key: "title", value: "hello WORLD", expect: "Hello World"},
+		{lang: "en", key: "capitalize", value: "hello WORLD", expect: "Hello WORLD"},
+		{lang: "en", key: "capitalize", value: "", expect: ""},
+		{lang: "en", key: "capitalize", value: "e-mail address", expect: "E-mail address"},
+		{lang: "en", key: "capitalize", value: "o'neill", expect: "O'neill"},
+		{lang: "nl", key: "capitalize", value: "'s-hertogenbosch", expect: "'s-hertogenbosch"},
+		{lang: "el", key: "capitalize", value: "όνομα", expect: "Όνομα"},
+		{lang: "de", key: "capitalize", value: "über", expect: "Über"},
+		{lang: "el", key: "lower", value: "ΟΔΟΣ", expect: "οδος"},
+		{lang: "el", key: "upper", value: "οδός", expect: "ΟΔΟΣ"},
+		{lang: "de", key: "upper", value: "straße", expect: "STRASSE"},
+	}
+
+	for _, tc := range cases {
+		ctx := WithLanguage(context.Background(), tc.lang)
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, map[string]any{"name": tc.value}), "%s %s %v", tc.lang, tc.key, tc.value)
+	}
+}
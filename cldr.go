@@ -0,0 +1,372 @@
+package lingua
+
+// The locale data of the transformers is a subset of the CLDR 45 data (https://cldr.unicode.org): the gregorian
+// calendars of the date transformers, the date fields of the relative transformer and the list patterns of the list
+// transformer. Languages that are not listed use the data of en, which is reported when the messages are loaded,
+// see ErrMissingLocale.
+// Keep all tables in this file, so the data can be compared with a new CLDR version in one place.
+
+// dateLocales holds the date data per language or language and region. Languages that are not listed use en.
+// Every locale has the same skeletons.
+var dateLocales = map[string]*dateLocale{
+	"en": {
+		months:          [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths:     [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		days:            [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		shortDays:       [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		am:              "AM",
+		pm:              "PM",
+		dateFormats:     [4]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+		timeFormats:     [4]string{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"},
+		dateTimeFormats: [4]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+		skeletons: map[string]string{
+			"yMd":    "M/d/y",
+			"yMMM":   "MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMd":  "MMM d, y",
+			"yMMMEd": "EEE, MMM d, y",
+			"MMMd":   "MMM d",
+			"MMMMd":  "MMMM d",
+			"MEd":    "EEE, M/d",
+			"Ed":     "d EEE",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+	"en-GB": {
+		months:          [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths:     [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sept", "Oct", "Nov", "Dec"},
+		days:            [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		shortDays:       [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		am:              "am",
+		pm:              "pm",
+		dateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [4]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+		skeletons: map[string]string{
+			"yMd":    "dd/MM/y",
+			"yMMM":   "MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMMMEd": "EEE, d MMM y",
+			"MMMd":   "d MMM",
+			"MMMMd":  "d MMMM",
+			"MEd":    "EEE dd/MM",
+			"Ed":     "EEE d",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+	"nl": {
+		months:          [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		shortMonths:     [12]string{"jan.", "feb.", "mrt.", "apr.", "mei", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "dec."},
+		days:            [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		shortDays:       [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		am:              "a.m.",
+		pm:              "p.m.",
+		dateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [4]string{"{1} 'om' {0}", "{1} 'om' {0}", "{1} {0}", "{1} {0}"},
+		skeletons: map[string]string{
+			"yMd":    "d-M-y",
+			"yMMM":   "MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMMMEd": "EEE d MMM y",
+			"MMMd":   "d MMM",
+			"MMMMd":  "d MMMM",
+			"MEd":    "EEE d-M",
+			"Ed":     "EEE d",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+	"de": {
+		months:          [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths:     [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		days:            [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortDays:       [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		am:              "AM",
+		pm:              "PM",
+		dateFormats:     [4]string{"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [4]string{"{1} 'um' {0}", "{1} 'um' {0}", "{1}, {0}", "{1}, {0}"},
+		skeletons: map[string]string{
+			"yMd":    "d.M.y",
+			"yMMM":   "MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMd":  "d. MMM y",
+			"yMMMEd": "EEE, d. MMM y",
+			"MMMd":   "d. MMM",
+			"MMMMd":  "d. MMMM",
+			"MEd":    "EEE, d.M.",
+			"Ed":     "EEE, d.",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+	"fr": {
+		months:          [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths:     [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		days:            [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortDays:       [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		am:              "AM",
+		pm:              "PM",
+		dateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats: [4]string{"{1} 'à' {0}", "{1} 'à' {0}", "{1}, {0}", "{1} {0}"},
+		skeletons: map[string]string{
+			"yMd":    "dd/MM/y",
+			"yMMM":   "MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMMMEd": "EEE d MMM y",
+			"MMMd":   "d MMM",
+			"MMMMd":  "d MMMM",
+			"MEd":    "EEE dd/MM",
+			"Ed":     "EEE d",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+	"es": {
+		months:          [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths:     [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		days:            [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortDays:       [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		am:              "a. m.",
+		pm:              "p. m.",
+		dateFormats:     [4]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"},
+		timeFormats:     [4]string{"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		dateTimeFormats: [4]string{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"},
+		skeletons: map[string]string{
+			"yMd":    "d/M/y",
+			"yMMM":   "MMM y",
+			"yMMMM":  "MMMM 'de' y",
+			"yMMMd":  "d MMM y",
+			"yMMMEd": "EEE, d MMM y",
+			"MMMd":   "d MMM",
+			"MMMMd":  "d 'de' MMMM",
+			"MEd":    "EEE, d/M",
+			"Ed":     "EEE d",
+			"Hm":     "H:mm",
+			"Hms":    "H:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+	"pl": {
+		months:           [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		standaloneMonths: [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+		shortMonths:      [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+		days:             [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		shortDays:        [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		am:               "AM",
+		pm:               "PM",
+		dateFormats:      [4]string{"EEEE, d MMMM y", "d MMMM y", "d MMM y", "d.MM.y"},
+		timeFormats:      [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateTimeFormats:  [4]string{"{1} 'o' {0}", "{1} 'o' {0}", "{1}, {0}", "{1}, {0}"},
+		skeletons: map[string]string{
+			"yMd":    "d.MM.y",
+			"yMMM":   "LLL y",
+			"yMMMM":  "LLLL y",
+			"yMMMd":  "d MMM y",
+			"yMMMEd": "EEE, d MMM y",
+			"MMMd":   "d MMM",
+			"MMMMd":  "d MMMM",
+			"MEd":    "EEE, d.MM",
+			"Ed":     "EEE, d",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+		},
+	},
+}
+
+// relativeLocales holds the relative time data per language. Languages that are not listed use en.
+var relativeLocales = map[string]*relativeLocale{
+	"en": {
+		now: "now",
+		units: [2][7]relativePatterns{
+			{
+				{future: map[string]string{"one": "in {0} year", "other": "in {0} years"}, past: map[string]string{"one": "{0} year ago", "other": "{0} years ago"}},
+				{future: map[string]string{"one": "in {0} month", "other": "in {0} months"}, past: map[string]string{"one": "{0} month ago", "other": "{0} months ago"}},
+				{future: map[string]string{"one": "in {0} week", "other": "in {0} weeks"}, past: map[string]string{"one": "{0} week ago", "other": "{0} weeks ago"}},
+				{future: map[string]string{"one": "in {0} day", "other": "in {0} days"}, past: map[string]string{"one": "{0} day ago", "other": "{0} days ago"}},
+				{future: map[string]string{"one": "in {0} hour", "other": "in {0} hours"}, past: map[string]string{"one": "{0} hour ago", "other": "{0} hours ago"}},
+				{future: map[string]string{"one": "in {0} minute", "other": "in {0} minutes"}, past: map[string]string{"one": "{0} minute ago", "other": "{0} minutes ago"}},
+				{future: map[string]string{"one": "in {0} second", "other": "in {0} seconds"}, past: map[string]string{"one": "{0} second ago", "other": "{0} seconds ago"}},
+			},
+			{
+				{future: map[string]string{"other": "in {0} yr."}, past: map[string]string{"other": "{0} yr. ago"}},
+				{future: map[string]string{"other": "in {0} mo."}, past: map[string]string{"other": "{0} mo. ago"}},
+				{future: map[string]string{"other": "in {0} wk."}, past: map[string]string{"other": "{0} wk. ago"}},
+				{future: map[string]string{"one": "in {0} day", "other": "in {0} days"}, past: map[string]string{"one": "{0} day ago", "other": "{0} days ago"}},
+				{future: map[string]string{"other": "in {0} hr."}, past: map[string]string{"other": "{0} hr. ago"}},
+				{future: map[string]string{"other": "in {0} min."}, past: map[string]string{"other": "{0} min. ago"}},
+				{future: map[string]string{"other": "in {0} sec."}, past: map[string]string{"other": "{0} sec. ago"}},
+			},
+		},
+	},
+	"nl": {
+		now: "nu",
+		units: [2][7]relativePatterns{
+			{
+				{future: map[string]string{"other": "over {0} jaar"}, past: map[string]string{"other": "{0} jaar geleden"}},
+				{future: map[string]string{"one": "over {0} maand", "other": "over {0} maanden"}, past: map[string]string{"one": "{0} maand geleden", "other": "{0} maanden geleden"}},
+				{future: map[string]string{"one": "over {0} week", "other": "over {0} weken"}, past: map[string]string{"one": "{0} week geleden", "other": "{0} weken geleden"}},
+				{future: map[string]string{"one": "over {0} dag", "other": "over {0} dagen"}, past: map[string]string{"one": "{0} dag geleden", "other": "{0} dagen geleden"}},
+				{future: map[string]string{"other": "over {0} uur"}, past: map[string]string{"other": "{0} uur geleden"}},
+				{future: map[string]string{"one": "over {0} minuut", "other": "over {0} minuten"}, past: map[string]string{"one": "{0} minuut geleden", "other": "{0} minuten geleden"}},
+				{future: map[string]string{"one": "over {0} seconde", "other": "over {0} seconden"}, past: map[string]string{"one": "{0} seconde geleden", "other": "{0} seconden geleden"}},
+			},
+			{
+				{future: map[string]string{"other": "over {0} jr."}, past: map[string]string{"other": "{0} jr. geleden"}},
+				{future: map[string]string{"other": "over {0} mnd"}, past: map[string]string{"other": "{0} mnd geleden"}},
+				{future: map[string]string{"other": "over {0} wk"}, past: map[string]string{"other": "{0} wk geleden"}},
+				{future: map[string]string{"one": "over {0} dag", "other": "over {0} dgn"}, past: map[string]string{"one": "{0} dag geleden", "other": "{0} dgn geleden"}},
+				{future: map[string]string{"other": "over {0} uur"}, past: map[string]string{"other": "{0} uur geleden"}},
+				{future: map[string]string{"other": "over {0} min."}, past: map[string]string{"other": "{0} min. geleden"}},
+				{future: map[string]string{"other": "over {0} sec."}, past: map[string]string{"other": "{0} sec. geleden"}},
+			},
+		},
+	},
+	"de": {
+		now: "jetzt",
+		units: [2][7]relativePatterns{
+			{
+				{future: map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"}, past: map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"}},
+				{future: map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"}, past: map[string]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"}},
+				{future: map[string]string{"one": "in {0} Woche", "other": "in {0} Wochen"}, past: map[string]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"}},
+				{future: map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"}, past: map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"}},
+				{future: map[string]string{"one": "in {0} Stunde", "other": "in {0} Stunden"}, past: map[string]string{"one": "vor {0} Stunde", "other": "vor {0} Stunden"}},
+				{future: map[string]string{"one": "in {0} Minute", "other": "in {0} Minuten"}, past: map[string]string{"one": "vor {0} Minute", "other": "vor {0} Minuten"}},
+				{future: map[string]string{"one": "in {0} Sekunde", "other": "in {0} Sekunden"}, past: map[string]string{"one": "vor {0} Sekunde", "other": "vor {0} Sekunden"}},
+			},
+			{
+				{future: map[string]string{"other": "in {0} J."}, past: map[string]string{"other": "vor {0} J."}},
+				{future: map[string]string{"other": "in {0} Mon."}, past: map[string]string{"other": "vor {0} Mon."}},
+				{future: map[string]string{"other": "in {0} Wo."}, past: map[string]string{"other": "vor {0} Wo."}},
+				{future: map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"}, past: map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"}},
+				{future: map[string]string{"other": "in {0} Std."}, past: map[string]string{"other": "vor {0} Std."}},
+				{future: map[string]string{"other": "in {0} Min."}, past: map[string]string{"other": "vor {0} Min."}},
+				{future: map[string]string{"other": "in {0} Sek."}, past: map[string]string{"other": "vor {0} Sek."}},
+			},
+		},
+	},
+	"fr": {
+		now: "maintenant",
+		units: [2][7]relativePatterns{
+			{
+				{future: map[string]string{"one": "dans {0} an", "other": "dans {0} ans"}, past: map[string]string{"one": "il y a {0} an", "other": "il y a {0} ans"}},
+				{future: map[string]string{"other": "dans {0} mois"}, past: map[string]string{"other": "il y a {0} mois"}},
+				{future: map[string]string{"one": "dans {0} semaine", "other": "dans {0} semaines"}, past: map[string]string{"one": "il y a {0} semaine", "other": "il y a {0} semaines"}},
+				{future: map[string]string{"one": "dans {0} jour", "other": "dans {0} jours"}, past: map[string]string{"one": "il y a {0} jour", "other": "il y a {0} jours"}},
+				{future: map[string]string{"one": "dans {0} heure", "other": "dans {0} heures"}, past: map[string]string{"one": "il y a {0} heure", "other": "il y a {0} heures"}},
+				{future: map[string]string{"one": "dans {0} minute", "other": "dans {0} minutes"}, past: map[string]string{"one": "il y a {0} minute", "other": "il y a {0} minutes"}},
+				{future: map[string]string{"one": "dans {0} seconde", "other": "dans {0} secondes"}, past: map[string]string{"one": "il y a {0} seconde", "other": "il y a {0} secondes"}},
+			},
+			{
+				{future: map[string]string{"other": "dans {0} a"}, past: map[string]string{"other": "il y a {0} a"}},
+				{future: map[string]string{"other": "dans {0} m."}, past: map[string]string{"other": "il y a {0} m."}},
+				{future: map[string]string{"other": "dans {0} sem."}, past: map[string]string{"other": "il y a {0} sem."}},
+				{future: map[string]string{"other": "dans {0} j"}, past: map[string]string{"other": "il y a {0} j"}},
+				{future: map[string]string{"other": "dans {0} h"}, past: map[string]string{"other": "il y a {0} h"}},
+				{future: map[string]string{"other": "dans {0} min"}, past: map[string]string{"other": "il y a {0} min"}},
+				{future: map[string]string{"other": "dans {0} s"}, past: map[string]string{"other": "il y a {0} s"}},
+			},
+		},
+	},
+	"es": {
+		now: "ahora",
+		units: [2][7]relativePatterns{
+			{
+				{future: map[string]string{"one": "dentro de {0} año", "other": "dentro de {0} años"}, past: map[string]string{"one": "hace {0} año", "other": "hace {0} años"}},
+				{future: map[string]string{"one": "dentro de {0} mes", "other": "dentro de {0} meses"}, past: map[string]string{"one": "hace {0} mes", "other": "hace {0} meses"}},
+				{future: map[string]string{"one": "dentro de {0} semana", "other": "dentro de {0} semanas"}, past: map[string]string{"one": "hace {0} semana", "other": "hace {0} semanas"}},
+				{future: map[string]string{"one": "dentro de {0} día", "other": "dentro de {0} días"}, past: map[string]string{"one": "hace {0} día", "other": "hace {0} días"}},
+				{future: map[string]string{"one": "dentro de {0} hora", "other": "dentro de {0} horas"}, past: map[string]string{"one": "hace {0} hora", "other": "hace {0} horas"}},
+				{future: map[string]string{"one": "dentro de {0} minuto", "other": "dentro de {0} minutos"}, past: map[string]string{"one": "hace {0} minuto", "other": "hace {0} minutos"}},
+				{future: map[string]string{"one": "dentro de {0} segundo", "other": "dentro de {0} segundos"}, past: map[string]string{"one": "hace {0} segundo", "other": "hace {0} segundos"}},
+			},
+			{
+				{future: map[string]string{"other": "dentro de {0} a"}, past: map[string]string{"other": "hace {0} a"}},
+				{future: map[string]string{"other": "dentro de {0} m"}, past: map[string]string{"other": "hace {0} m"}},
+				{future: map[string]string{"other": "dentro de {0} sem."}, past: map[string]string{"other": "hace {0} sem."}},
+				{future: map[string]string{"other": "dentro de {0} d"}, past: map[string]string{"other": "hace {0} d"}},
+				{future: map[string]string{"other": "dentro de {0} h"}, past: map[string]string{"other": "hace {0} h"}},
+				{future: map[string]string{"other": "dentro de {0} min"}, past: map[string]string{"other": "hace {0} min"}},
+				{future: map[string]string{"other": "dentro de {0} s"}, past: map[string]string{"other": "hace {0} s"}},
+			},
+		},
+	},
+	"pl": {
+		now: "teraz",
+		units: [2][7]relativePatterns{
+			{
+				{future: map[string]string{"one": "za {0} rok", "few": "za {0} lata", "many": "za {0} lat", "other": "za {0} roku"}, past: map[string]string{"one": "{0} rok temu", "few": "{0} lata temu", "many": "{0} lat temu", "other": "{0} roku temu"}},
+				{future: map[string]string{"one": "za {0} miesiąc", "few": "za {0} miesiące", "many": "za {0} miesięcy", "other": "za {0} miesiąca"}, past: map[string]string{"one": "{0} miesiąc temu", "few": "{0} miesiące temu", "many": "{0} miesięcy temu", "other": "{0} miesiąca temu"}},
+				{future: map[string]string{"one": "za {0} tydzień", "few": "za {0} tygodnie", "many": "za {0} tygodni", "other": "za {0} tygodnia"}, past: map[string]string{"one": "{0} tydzień temu", "few": "{0} tygodnie temu", "many": "{0} tygodni temu", "other": "{0} tygodnia temu"}},
+				{future: map[string]string{"one": "za {0} dzień", "few": "za {0} dni", "many": "za {0} dni", "other": "za {0} dnia"}, past: map[string]string{"one": "{0} dzień temu", "few": "{0} dni temu", "many": "{0} dni temu", "other": "{0} dnia temu"}},
+				{future: map[string]string{"one": "za {0} godzinę", "few": "za {0} godziny", "many": "za {0} godzin", "other": "za {0} godziny"}, past: map[string]string{"one": "{0} godzinę temu", "few": "{0} godziny temu", "many": "{0} godzin temu", "other": "{0} godziny temu"}},
+				{future: map[string]string{"one": "za {0} minutę", "few": "za {0} minuty", "many": "za {0} minut", "other": "za {0} minuty"}, past: map[string]string{"one": "{0} minutę temu", "few": "{0} minuty temu", "many": "{0} minut temu", "other": "{0} minuty temu"}},
+				{future: map[string]string{"one": "za {0} sekundę", "few": "za {0} sekundy", "many": "za {0} sekund", "other": "za {0} sekundy"}, past: map[string]string{"one": "{0} sekundę temu", "few": "{0} sekundy temu", "many": "{0} sekund temu", "other": "{0} sekundy temu"}},
+			},
+			{
+				{future: map[string]string{"one": "za {0} rok", "few": "za {0} lata", "many": "za {0} lat", "other": "za {0} roku"}, past: map[string]string{"one": "{0} rok temu", "few": "{0} lata temu", "many": "{0} lat temu", "other": "{0} roku temu"}},
+				{future: map[string]string{"other": "za {0} mies."}, past: map[string]string{"other": "{0} mies. temu"}},
+				{future: map[string]string{"one": "za {0} tydz.", "other": "za {0} tyg."}, past: map[string]string{"one": "{0} tydz. temu", "other": "{0} tyg. temu"}},
+				{future: map[string]string{"one": "za {0} dzień", "few": "za {0} dni", "many": "za {0} dni", "other": "za {0} dnia"}, past: map[string]string{"one": "{0} dzień temu", "few": "{0} dni temu", "many": "{0} dni temu", "other": "{0} dnia temu"}},
+				{future: map[string]string{"other": "za {0} godz."}, past: map[string]string{"other": "{0} godz. temu"}},
+				{future: map[string]string{"other": "za {0} min"}, past: map[string]string{"other": "{0} min temu"}},
+				{future: map[string]string{"other": "za {0} sek."}, past: map[string]string{"other": "{0} sek. temu"}},
+			},
+		},
+	},
+}
+
+// listLocales holds the list patterns per language or language and region, indexed by listStyle.
+// Languages that are not listed use en.
+var listLocales = map[string][3]listPatterns{
+	"en": {
+		{middle: "{0}, {1}", end: "{0}, and {1}", two: "{0} and {1}"},
+		{middle: "{0}, {1}", end: "{0}, or {1}", two: "{0} or {1}"},
+		{middle: "{0}, {1}", end: "{0}, {1}", two: "{0}, {1}"},
+	},
+	"en-GB": {
+		{middle: "{0}, {1}", end: "{0} and {1}", two: "{0} and {1}"},
+		{middle: "{0}, {1}", end: "{0} or {1}", two: "{0} or {1}"},
+		{middle: "{0}, {1}", end: "{0}, {1}", two: "{0}, {1}"},
+	},
+	"nl": {
+		{middle: "{0}, {1}", end: "{0} en {1}", two: "{0} en {1}"},
+		{middle: "{0}, {1}", end: "{0} of {1}", two: "{0} of {1}"},
+		{middle: "{0}, {1}", end: "{0} en {1}", two: "{0} en {1}"},
+	},
+	"de": {
+		{middle: "{0}, {1}", end: "{0} und {1}", two: "{0} und {1}"},
+		{middle: "{0}, {1}", end: "{0} oder {1}", two: "{0} oder {1}"},
+		{middle: "{0}, {1}", end: "{0} und {1}", two: "{0}, {1}"},
+	},
+	"fr": {
+		{middle: "{0}, {1}", end: "{0} et {1}", two: "{0} et {1}"},
+		{middle: "{0}, {1}", end: "{0} ou {1}", two: "{0} ou {1}"},
+		{middle: "{0}, {1}", end: "{0} et {1}", two: "{0} et {1}"},
+	},
+	"es": {
+		{middle: "{0}, {1}", end: "{0} y {1}", two: "{0} y {1}"},
+		{middle: "{0}, {1}", end: "{0} o {1}", two: "{0} o {1}"},
+		{middle: "{0}, {1}", end: "{0} y {1}", two: "{0} y {1}"},
+	},
+}
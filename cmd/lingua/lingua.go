@@ -1,16 +1,19 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
 	"sort"
+	"strings"
 
 	"github.com/SLASH2NL/lingua"
 	"github.com/SLASH2NL/lingua/extract"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"gopkg.in/yaml.v3"
 )
 
@@ -38,12 +41,22 @@ $ lingua extract en ./src ./translations --remove
 
 		remove := cmd.Flag("remove").Value.String() == "true"
 
+		syntax := lingua.SyntaxLingua
+		if cmd.Flag("icu").Value.String() == "true" {
+			syntax = lingua.SyntaxICU
+		}
+
 		// First read all existing translations.
+		// The custom transformers of the application are unknown, but they are kept in the raw messages.
+		// All files are read to report every invalid message at once, the files are not updated if there is an error.
 		existing, err := lingua.ContainerFromFs(
 			afero.NewBasePathFs(afero.NewOsFs(), translationDir),
+			lingua.WithSyntax(syntax),
+			lingua.WithUnregisteredTransformers(),
+			lingua.WithLenientLoad(),
 		)
-		if err != nil {
-			return fmt.Errorf("error reading existing translations: %w", err)
+		if err = ignoreMissingLocales(err); err != nil {
+			return fmt.Errorf("error reading existing translations:\n%w", highlightLoadErrors(err))
 		}
 
 		srcMessages, err := extractMessages(dir)
@@ -51,9 +64,14 @@ $ lingua extract en ./src ./translations --remove
 			return fmt.Errorf("error extracting messages: %w", err)
 		}
 
+		// The translations are written back in the syntax of the files, which can fail for messages that ICU can not express.
+		existingMessages, err := existing.Export()
+		if err != nil {
+			return fmt.Errorf("error writing existing translations:\n%w", err)
+		}
+
 		// Traverse all existing translations and add new keys if they are not present.
 		// If remove is set, remove all translations that are not found in the source code.
-		existingMessages := existing.Raw()
 		for langID, messages := range existingMessages {
 			for _, key := range srcMessages {
 				if _, ok := messages[key]; ok {
@@ -125,6 +143,7 @@ $ lingua extract en ./src ./translations --remove
 
 func init() {
 	extractCmd.Flags().Bool("remove", false, "Remove all translations in the translation files that have not been found in DIR.")
+	extractCmd.Flags().Bool("icu", false, "Read and write the translation files using the ICU MessageFormat syntax.")
 	rootCmd.AddCommand(extractCmd)
 }
 
@@ -132,6 +151,58 @@ func main() {
 	cobra.CheckErr(rootCmd.Execute())
 }
 
+// ignoreMissingLocales removes the lingua.ErrMissingLocale errors from the lingua.LoadErrors.
+// Missing locale data only changes how messages are formatted, the raw messages are complete.
+func ignoreMissingLocales(err error) error {
+	var loadErrs lingua.LoadErrors
+	if !errors.As(err, &loadErrs) {
+		return err
+	}
+
+	loadErrs = slices.DeleteFunc(slices.Clone(loadErrs), func(err error) bool {
+		return errors.Is(err, lingua.ErrMissingLocale)
+	})
+	if len(loadErrs) == 0 {
+		return nil
+	}
+
+	return loadErrs
+}
+
+// highlightLoadErrors highlights the ParseError of every error in the lingua.LoadErrors.
+func highlightLoadErrors(err error) error {
+	var loadErrs lingua.LoadErrors
+	if !errors.As(err, &loadErrs) {
+		return highlightParseError(err)
+	}
+
+	errs := make([]error, 0, len(loadErrs))
+	for _, err := range loadErrs {
+		errs = append(errs, highlightParseError(err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// highlightParseError adds the message of a lingua.ParseError to the error with the invalid part highlighted.
+// The part is colored if stderr is a terminal, otherwise it is marked with carets on the next line.
+func highlightParseError(err error) error {
+	var parseErr *lingua.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Offset < 0 {
+		return err
+	}
+
+	msg := parseErr.Message
+	start := min(parseErr.Offset, len(msg))
+	end := min(start+len(parseErr.Found), len(msg))
+
+	if term.IsTerminal(int(os.Stderr.Fd())) {
+		return fmt.Errorf("%w\n\t%s\033[4m\033[1;31m%s\033[0m%s", err, msg[:start], msg[start:end], msg[end:])
+	}
+
+	return fmt.Errorf("%w\n\t%s\n\t%s%s", err, msg, strings.Repeat(" ", start), strings.Repeat("^", max(end-start, 1)))
+}
+
 func extractMessages(srcDir string) ([]string, error) {
 	messages, err := extract.KeysFromSource(srcDir)
 	if err != nil {
@@ -2,17 +2,20 @@ package lingua
 
 import (
 	"context"
+	"encoding"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"reflect"
-	"strconv"
+	"slices"
 	"strings"
-	"unicode"
-	"unicode/utf8"
+	"time"
 
 	"github.com/SLASH2NL/lingua/internal/parser"
 	"github.com/spf13/afero"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
 	"gopkg.in/yaml.v3"
 )
 
@@ -28,6 +31,7 @@ func ContainerFromFsAndMatcher(fs afero.Fs, matcher FileMatcher, opts ...Contain
 	c := &Container{
 		messages: make(map[LanguageID]map[Key]*parser.Message),
 	}
+	c.transformers = c.builtinTransformers()
 
 	for _, opt := range opts {
 		opt(c)
@@ -39,6 +43,9 @@ func ContainerFromFsAndMatcher(fs afero.Fs, matcher FileMatcher, opts ...Contain
 		return nil, fmt.Errorf("unable to read fs: %w", err)
 	}
 
+	// errs holds all errors if the container is loaded leniently, otherwise only the first error is returned.
+	var errs LoadErrors
+
 	files := make(map[LanguageID]string)
 	for _, entry := range entries {
 		if entry.IsDir() {
@@ -51,27 +58,82 @@ func ContainerFromFsAndMatcher(fs afero.Fs, matcher FileMatcher, opts ...Contain
 
 		langID, err := matcher.LanguageID(entry.Name())
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse language %q: %w", entry.Name(), err)
+			err = fmt.Errorf("unable to parse language %q: %w", entry.Name(), err)
+			if !c.lenient {
+				return nil, err
+			}
+
+			errs = append(errs, err)
+			continue
 		}
 
 		if _, ok := files[langID]; ok {
-			return nil, fmt.Errorf("duplicate language file %q for language %s", entry.Name(), langID.String())
+			err = fmt.Errorf("duplicate language file %q for language %s", entry.Name(), langID.String())
+			if !c.lenient {
+				return nil, err
+			}
+
+			errs = append(errs, err)
+			continue
 		}
 
 		files[langID] = entry.Name()
 	}
 
-	for langID, file := range files {
+	// Load the files in order of the file name to always report the errors in the same order.
+	langIDs := make([]LanguageID, 0, len(files))
+	for langID := range files {
+		langIDs = append(langIDs, langID)
+	}
+	slices.SortFunc(langIDs, func(a, b LanguageID) int {
+		return strings.Compare(files[a], files[b])
+	})
+
+	for _, langID := range langIDs {
+		file := files[langID]
+
 		f, err := fs.Open(file)
 		if err != nil {
-			return nil, fmt.Errorf("unable to open file %q: %w", file, err)
+			err = fmt.Errorf("unable to open file %q: %w", file, err)
+			if !c.lenient {
+				return nil, err
+			}
+
+			errs = append(errs, err)
+			continue
 		}
 		defer f.Close()
 
-		err = c.addFile(langID, f)
+		fileErrs := c.addFile(file, langID, f)
+		if len(fileErrs) > 0 && !c.lenient {
+			return nil, fileErrs[0]
+		}
+
+		errs = append(errs, fileErrs...)
+
+		err = c.checkReferences(langID)
 		if err != nil {
-			return nil, fmt.Errorf("unable to add file %q: %w", file, err)
+			err = fmt.Errorf("invalid file %q: %w", file, err)
+			if !c.lenient {
+				return nil, err
+			}
+
+			errs = append(errs, err)
+		}
+
+		localeErrs := c.checkLocales(file, langID)
+		if len(localeErrs) > 0 && !c.lenient {
+			return nil, localeErrs[0]
 		}
+
+		errs = append(errs, localeErrs...)
+	}
+
+	c.resolveLanguages()
+
+	if len(errs) > 0 {
+		// The container holds all messages that could be loaded.
+		return c, errs
 	}
 
 	return c, nil
@@ -81,44 +143,102 @@ type Container struct {
 	messages map[LanguageID]map[Key]*parser.Message
 
 	defaultLanguage LanguageID
+	syntax          Syntax
+
+	// transformers holds the factories of the transformers that can be used in messages by name.
+	transformers             map[string]TransformerFactory
+	unregisteredTransformers bool
+
+	missingReplacementPolicy  MissingReplacementPolicy
+	missingReplacementDefault string
+
+	// languages holds the loaded language that is used for a language without an exact match by language,
+	// and by language and script, like en-GB for en-AU or zh-Hant-TW for zh-Hant-HK.
+	languages        map[LanguageID]LanguageID
+	preferredRegions map[string]string
+
+	// tags holds the parsed language.Tag of the loaded languages.
+	tags map[LanguageID]language.Tag
+
+	// fallbacks holds the languages that are used for a key that is missing in a loaded language, in order.
+	fallbacks      map[LanguageID][]LanguageID
+	fallbackChains map[LanguageID][]LanguageID
+
+	// matcher matches the supported languages, which are the loaded languages, for Negotiate.
+	matcher   language.Matcher
+	supported []LanguageID
+
+	// lenient loads all valid messages and collects the errors instead of stopping at the first error.
+	lenient bool
 }
 
 func (c *Container) Message(ctx context.Context, key Key, replacements map[string]any) string {
+	// The error is a missing replacement or an invalid currency, the message is formatted without them.
+	message, _ := c.Format(ctx, key, replacements)
+	return message
+}
+
+// Format formats the message like Message, but returns an ErrMissingReplacement error if a replacement is missing
+// and the MissingReplacementPolicy is MissingReplacementError. The message with the placeholders is also returned.
+// An ErrInvalidCurrency error is returned if the currency code of a replacement, like :price|currency(:code), is invalid.
+func (c *Container) Format(ctx context.Context, key Key, replacements map[string]any) (string, error) {
 	lang := c.ScopedLanguage(ctx)
 	if lang.Empty() {
-		return string(key)
+		return string(key), nil
 	}
 
-	scope := c.messages[lang]
-
-	msg, ok := scope[key]
+	lang, msg, ok := c.lookup(lang, key)
 	if !ok {
-		return string(key)
+		return string(key), nil
 	}
 
-	formattedReplacements := make(map[string]string)
-	for key, value := range replacements {
-		formattedReplacements[key] = formatReplacement(value)
+	f := c.newFormatter(ctx, lang, key, replacements)
+	message := f.format(msg.Ops, "")
+
+	return message, f.err
+}
+
+// newFormatter returns a formatter for the message with the key.
+func (c *Container) newFormatter(ctx context.Context, lang LanguageID, key Key, replacements map[string]any) *formatter {
+	return &formatter{
+		c:            c,
+		ctx:          ctx,
+		lang:         lang,
+		replacements: replacements,
+		references:   []Key{key},
 	}
+}
+
+// formatter formats messages of a single language with a fixed set of replacements.
+type formatter struct {
+	c            *Container
+	ctx          context.Context
+	lang         LanguageID
+	replacements map[string]any
+
+	// err is the first error of the message, like a missing replacement if the policy is MissingReplacementError.
+	err error
 
-	return c.format(msg, formattedReplacements, scope)
+	// references holds the keys of the messages that are being formatted, to guard against reference cycles.
+	references []Key
 }
 
-func (c *Container) format(msg *parser.Message, replacements map[string]string, messages map[Key]*parser.Message) string {
+// format formats the ops to a string. The count is used for the PluralCountOp in the case of a plural transformer.
+func (f *formatter) format(ops []any, count string) string {
 	var b strings.Builder
 
 	// Simple pre-allocate the buffer.
-	// This does not take into account transformers.
+	// This does not take into account transformers and replacements that are not a string.
 	length := 0
-	for _, t := range msg.Ops {
+	for _, t := range ops {
 		switch v := t.(type) {
 		case parser.ReplacementOp:
 			// Check if a replacement is provided.
-			if rep, ok := replacements[v.Key]; ok {
-				length += len(rep)
-			} else {
+			if rep, ok := lookupReplacement(f.replacements, v); !ok {
 				// If no replacement provided, leave the placeholder as-is.
 				length += len(v.Key) + 1
+			} else if rep, ok := rep.(string); ok {
+				length += len(rep)
 			}
 		case parser.LiteralOp:
 			length += len(v.Value)
@@ -127,68 +247,138 @@ func (c *Container) format(msg *parser.Message, replacements map[string]string,
 
 	b.Grow(length)
 
-	var replacementB strings.Builder
-	for _, t := range msg.Ops {
+	for i, t := range ops {
 		switch v := t.(type) {
 		case parser.LiteralOp:
 			b.WriteString(v.Value)
+		case parser.PluralCountOp:
+			b.WriteString(count)
+		case parser.ReferenceOp:
+			b.WriteString(f.reference(Key(v.Key)))
 		case parser.ReplacementOp:
-			value, ok := replacements[v.Key]
+			value, ok := lookupReplacement(f.replacements, v)
+			if value == nil {
+				// A default transformer provides the value for a missing or nil replacement.
+				// The value is set before the chain, so the default does not have to be the first transformer.
+				if def, hasDef := defaultValue(v.Transformers); hasDef {
+					value, ok = def, true
+				}
+			}
+
 			if !ok {
-				// If no replacement provided, leave the placeholder as-is.
-				b.WriteString(":" + v.Key)
+				var next any
+				if i+1 < len(ops) {
+					next = ops[i+1]
+				}
+
+				f.missing(&b, parser.Placeholder(v.Key, next), v.Key)
 				continue
 			}
 
+			// The value stays the raw replacement until a transformer formats it,
+			// so transformers like number can use the original value.
 			for _, transformer := range v.Transformers {
 				switch t := transformer.(type) {
-				case parser.CapitalizeTransformer:
-					r, size := utf8.DecodeRuneInString(value)
-
-					value = string(unicode.ToUpper(r)) + value[size:]
-				case parser.ReplaceTransformer:
-					if rep, ok := messages[Key(value)]; ok {
-						// Only allow literals as replacements.
-						value = rep.Raw()
+				case parser.Transformer:
+					switch impl := t.Impl.(type) {
+					case formatterTransformer:
+						value = impl.transform(f, value)
+					case Transformer:
+						value = impl.Transform(f.ctx, f.lang, value, f.replacements)
 					}
 				case parser.PluralTransformer:
-					// Convert value to int. If that fails we assume 0.
-					count, err := strconv.Atoi(value)
-					if err != nil {
-						count = 0
-					}
-
-					var ops []any
+					ops, count := selectPluralCase(plural.Cardinal, f.lang, value, t.Cases, t.Other)
+					value = f.format(ops, count)
+				case parser.OrdinalTransformer:
+					ops, count := selectPluralCase(plural.Ordinal, f.lang, value, t.Cases, t.Other)
+					value = f.format(ops, count)
+				case parser.SelectTransformer:
+					selected := formatReplacement(value)
+
+					ops := t.Other
 					for _, c := range t.Cases {
-						if c.Match(count) {
+						if c.Value == selected {
 							ops = c.Ops
 							break
 						}
 					}
 
-					if len(ops) == 0 {
-						ops = t.Other
-					}
-
-					replacementB.Reset()
-					for _, c := range ops {
-						switch c := c.(type) {
-						case parser.LiteralOp:
-							replacementB.WriteString(c.Value)
-						case parser.PluralCountOp:
-							replacementB.WriteString(strconv.Itoa(count))
-						}
-					}
-					value = replacementB.String()
+					// The case can contain replacements itself, so format it with the same replacements.
+					value = f.format(ops, count)
 				}
 			}
 
-			b.WriteString(value)
+			b.WriteString(formatReplacement(value))
 		}
 	}
 	return b.String()
 }
 
+// formatterTransformer is implemented by transformers that use the formatter of the message instead of Transform,
+// like list(translate) that shares its references and error, and the case transformers that use its tag.
+type formatterTransformer interface {
+	transform(f *formatter, value any) any
+}
+
+// tag returns the language.Tag of the language of the formatter, which is parsed when the messages are loaded.
+func (f *formatter) tag() language.Tag {
+	if tag, ok := f.c.tags[f.lang]; ok {
+		return tag
+	}
+
+	return f.lang.Tag()
+}
+
+// missing writes a missing replacement according to the MissingReplacementPolicy of the container.
+// The placeholder is written as-is, like :name or :{name} if the text that follows would otherwise be read as part of it.
+func (f *formatter) missing(b *strings.Builder, placeholder, name string) {
+	f.reportMissing(name)
+
+	switch f.c.missingReplacementPolicy {
+	case MissingReplacementEmpty:
+	case MissingReplacementDefault:
+		b.WriteString(f.c.missingReplacementDefault)
+	default:
+		// Leave the placeholder as-is.
+		b.WriteString(placeholder)
+	}
+}
+
+// reportMissing makes Format return an ErrMissingReplacement for the name if the policy is MissingReplacementError.
+func (f *formatter) reportMissing(name string) {
+	if f.c.missingReplacementPolicy == MissingReplacementError {
+		f.fail(fmt.Errorf("%w %q in message %q", ErrMissingReplacement, name, f.references[0]))
+	}
+}
+
+// fail keeps the first error of the message, which is returned by Format.
+func (f *formatter) fail(err error) {
+	if f.err == nil {
+		f.err = err
+	}
+}
+
+// reference formats the referenced message with the same replacements.
+// Like Message, a missing message is formatted as its key.
+func (f *formatter) reference(key Key) string {
+	lang, msg, ok := f.c.lookup(f.lang, key)
+	if !ok || slices.Contains(f.references, key) {
+		// Cycles are rejected when the messages are loaded, but can be introduced by Merge.
+		return string(key)
+	}
+
+	// A message from a fallback language is formatted in that language.
+	outer := f.lang
+	f.lang = lang
+	f.references = append(f.references, key)
+	defer func() {
+		f.lang = outer
+		f.references = f.references[:len(f.references)-1]
+	}()
+
+	return f.format(msg.Ops, "")
+}
+
 // Scope returns a container type with the ctx embedded.
 func (c *Container) Scope(ctx context.Context) *ScopedContainer {
 	return &ScopedContainer{
@@ -197,8 +387,10 @@ func (c *Container) Scope(ctx context.Context) *ScopedContainer {
 	}
 }
 
-// ScopedLanguage returns the language in the context or falls back to no strict matches or the default lang.
-// Returns and empty LanguageID{} if no language can be detected.
+// ScopedLanguage returns the loaded language that is used for the language in the ctx.
+// A language without an exact match uses the language without region, the preferred region of WithPreferredRegion
+// or the first region in alphabetical order, in that order. Otherwise the default language is used.
+// Returns an empty LanguageID{} if no language can be detected.
 func (c *Container) ScopedLanguage(ctx context.Context) LanguageID {
 	// Get the language from the context.
 	// Fallback to the defaultLanguage. If no language can be detected return the translation key.
@@ -211,18 +403,15 @@ func (c *Container) ScopedLanguage(ctx context.Context) LanguageID {
 		lang = c.defaultLanguage
 	}
 
-	var firstMatch LanguageID
-	for scoped := range c.messages {
-		isMatch, isExactMatch := scoped.Match(lang)
-		if isMatch && isExactMatch {
-			return scoped
-		} else if isMatch {
-			firstMatch = scoped
-		}
+	if _, ok := c.messages[lang]; ok {
+		return lang
 	}
 
-	if !firstMatch.Empty() {
-		return firstMatch
+	// Use the script of the language, or the likely script if the language has no script, like Hant for zh-TW.
+	// A language with another script, like zh-Hans-CN for zh-TW, is not used.
+	script := lang.likelyScript()
+	if scoped, ok := c.languages[LanguageID{Language: lang.Language, Script: script}]; ok {
+		return scoped
 	}
 
 	if !c.defaultLanguage.Empty() {
@@ -232,18 +421,47 @@ func (c *Container) ScopedLanguage(ctx context.Context) LanguageID {
 	return LanguageID{}
 }
 
-// Raw returns the raw messages from the container.
+// Raw returns the raw messages from the container in the syntax of the container.
+// Messages that can not be written in the syntax are returned in the lingua syntax, use Export to get the error.
 func (c *Container) Raw() map[LanguageID]map[string]string {
+	raw, _ := c.Export()
+	return raw
+}
+
+// Export returns the raw messages like Raw, but returns an error for the messages that can not be written in the
+// syntax of the container. A message in the lingua syntax, like a plural range added with Merge, can not always be
+// written in the ICU syntax. These messages are returned in the lingua syntax together with the error.
+func (c *Container) Export() (map[LanguageID]map[string]string, error) {
+	var errs []error
+
 	raw := make(map[LanguageID]map[string]string, len(c.messages))
-	for lang, messages := range c.messages {
+	// The languages and keys are sorted, so the errors are in a stable order.
+	languages := slices.SortedFunc(maps.Keys(c.messages), func(a, b LanguageID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	for _, lang := range languages {
 		raw[lang] = make(map[string]string)
 
-		for key, message := range messages {
-			raw[lang][string(key)] = message.Raw()
+		for _, key := range slices.Sorted(maps.Keys(c.messages[lang])) {
+			message := c.messages[lang][key]
+			if c.syntax != SyntaxICU {
+				raw[lang][string(key)] = message.Raw()
+				continue
+			}
+
+			icu, err := message.RawICU()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("unable to write message %q of %s in ICU: %w", key, lang, err))
+				raw[lang][string(key)] = message.Raw()
+				continue
+			}
+
+			raw[lang][string(key)] = icu
 		}
 	}
 
-	return raw
+	return raw, errors.Join(errs...)
 }
 
 func (c *Container) Messages(lang LanguageID) map[Key]*parser.Message {
@@ -291,29 +509,263 @@ func Merge(from *Container, to *Container, strategy MergeStrategy) *Container {
 		}
 	}
 
+	to.resolveLanguages()
+
 	return to
 }
 
-func (c *Container) addFile(language LanguageID, content io.Reader) error {
-	var rawMessages map[string]string
+// lookup returns the message with the key from the language, or from the first language in the fallback chain of the
+// language that has the message. The returned language is the language of the message.
+func (c *Container) lookup(lang LanguageID, key Key) (LanguageID, *parser.Message, bool) {
+	if msg, ok := c.messages[lang][key]; ok {
+		return lang, msg, true
+	}
+
+	for _, fallback := range c.fallbacks[lang] {
+		if msg, ok := c.messages[fallback][key]; ok {
+			return fallback, msg, true
+		}
+	}
+
+	return lang, nil, false
+}
 
-	err := yaml.NewDecoder(content).Decode(&rawMessages)
+// resolveLanguages determines the loaded language that is used for each language without an exact match,
+// so ScopedLanguage does not have to compare all loaded languages.
+func (c *Container) resolveLanguages() {
+	loaded := make([]LanguageID, 0, len(c.messages))
+	for lang := range c.messages {
+		loaded = append(loaded, lang)
+	}
+
+	// Sort the languages so the plain language is first and the scripts and regions are in alphabetical order.
+	slices.SortFunc(loaded, func(a, b LanguageID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	c.tags = make(map[LanguageID]language.Tag, len(loaded))
+	for _, lang := range loaded {
+		c.tags[lang] = lang.Tag()
+	}
+
+	// Every loaded language is a candidate for its language, and for its language and script if the script is known.
+	// The script is the likely script for a language without script, so zh-CN can use zh and zh-TW can not.
+	c.languages = make(map[LanguageID]LanguageID)
+	for _, lang := range loaded {
+		keys := []LanguageID{{Language: lang.Language}}
+		if script := lang.likelyScript(); script != "" {
+			keys = append(keys, LanguageID{Language: lang.Language, Script: script})
+		}
+
+		for _, key := range keys {
+			scoped, ok := c.languages[key]
+			switch {
+			case !ok:
+				c.languages[key] = lang
+			case scoped == key:
+				// The language without region is always preferred.
+			case lang == key:
+				c.languages[key] = lang
+			case scoped.Region == "" && scoped.Variants == "":
+				// The language without region is also preferred if its script is the likely script, like nl for nl-Latn.
+			case lang.Region != "" && lang.Region == c.preferredRegions[lang.Language]:
+				c.languages[key] = lang
+			}
+		}
+	}
+
+	c.newMatcher(loaded)
+
+	// Determine the fallback chain of every loaded language. Without a chain of WithFallback a language falls back to
+	// its parents, like zh-Hant-TW to zh-Hant and zh. The default language is always the last fallback.
+	c.fallbacks = make(map[LanguageID][]LanguageID)
+	for _, lang := range loaded {
+		chain, ok := c.fallbackChains[lang]
+		if !ok {
+			for parent := lang.Parent(); !parent.Empty(); parent = parent.Parent() {
+				chain = append(chain, parent)
+			}
+		}
+
+		if !c.defaultLanguage.Empty() && !slices.Contains(chain, c.defaultLanguage) {
+			chain = append(slices.Clip(chain), c.defaultLanguage)
+		}
+
+		// A language can not fall back to itself.
+		c.fallbacks[lang] = slices.DeleteFunc(slices.Clone(chain), func(fallback LanguageID) bool {
+			return fallback == lang
+		})
+	}
+}
+
+// addFile parses the messages of the file. It returns the errors of all messages that can not be parsed if the
+// container is loaded leniently, otherwise it stops at the first error.
+func (c *Container) addFile(file string, language LanguageID, content io.Reader) []error {
+	var document yaml.Node
+
+	err := yaml.NewDecoder(content).Decode(&document)
 	if err != nil && !errors.Is(err, io.EOF) {
-		return fmt.Errorf("unable to decode yaml: %w", err)
+		return []error{fmt.Errorf("unable to decode yaml file %q: %w", file, err)}
+	}
+
+	var rawMessages map[string]string
+
+	err = document.Decode(&rawMessages)
+	if err != nil {
+		return []error{fmt.Errorf("unable to decode yaml file %q: %w", file, err)}
 	}
 
 	c.messages[language] = make(map[Key]*parser.Message)
 
-	for key, raw := range rawMessages {
-		c.messages[language][Key(key)], err = parser.Parse(raw)
+	resolver := parser.WithResolver(c.resolveTransformer)
+	nodes := messageNodes(&document)
+
+	// Parse the messages in order of the key to always report the errors in the same order.
+	keys := make([]string, 0, len(rawMessages))
+	for key := range rawMessages {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var errs []error
+	for _, key := range keys {
+		raw := rawMessages[key]
+
+		var msg *parser.Message
+		if c.syntax == SyntaxICU {
+			msg, err = parser.ParseICU(raw, resolver)
+		} else {
+			msg, err = parser.Parse(raw, resolver)
+		}
 		if err != nil {
-			return fmt.Errorf("unable to parse message %q: %w", key, err)
+			// Messages from a yaml merge key have no node of their own, their position is unknown.
+			var line, column int
+			if node, ok := nodes[key]; ok {
+				line, column = node.Line, node.Column
+			}
+
+			errs = append(errs, newParseError(file, Key(key), raw, line, column, err))
+			if !c.lenient {
+				return errs
+			}
+
+			continue
+		}
+
+		c.messages[language][Key(key)] = msg
+	}
+
+	return errs
+}
+
+// messageNodes returns the value nodes of the messages in the yaml document by key.
+// The nodes hold the line and column of the messages.
+func messageNodes(document *yaml.Node) map[string]*yaml.Node {
+	nodes := make(map[string]*yaml.Node)
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return nodes
+	}
+
+	mapping := document.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		nodes[mapping.Content[i].Value] = mapping.Content[i+1]
+	}
+
+	return nodes
+}
+
+// checkReferences returns an error if the messages of the language reference each other in a cycle, like a -> b -> a.
+func (c *Container) checkReferences(language LanguageID) error {
+	messages := c.messages[language]
+
+	keys := make([]Key, 0, len(messages))
+	for key := range messages {
+		keys = append(keys, key)
+	}
+
+	// Sort the keys to always report the same cycle.
+	slices.Sort(keys)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[Key]int, len(messages))
+	path := make([]Key, 0)
+
+	var visit func(key Key) error
+	visit = func(key Key) error {
+		switch state[key] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := path[slices.Index(path, key):]
+			names := make([]string, 0, len(cycle)+1)
+			for _, k := range cycle {
+				names = append(names, string(k))
+			}
+
+			return fmt.Errorf("message reference cycle %s", strings.Join(append(names, string(key)), " -> "))
+		}
+
+		msg, ok := messages[key]
+		if !ok {
+			// Missing messages are formatted as their key.
+			return nil
+		}
+
+		state[key] = visiting
+		path = append(path, key)
+
+		for _, ref := range msg.References() {
+			if err := visit(Key(ref)); err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[key] = visited
+
+		return nil
+	}
+
+	for _, key := range keys {
+		if err := visit(key); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// checkLocales returns an ErrMissingLocale error for every transformer that has no locale data for the language,
+// like the date transformer for it. The first message that uses the transformer is reported.
+// The messages are loaded and formatted with the locale data of en.
+func (c *Container) checkLocales(file string, language LanguageID) []error {
+	messages := c.messages[language]
+
+	// Sort the keys to always report the same message.
+	keys := slices.Sorted(maps.Keys(messages))
+
+	var errs []error
+	reported := make(map[string]bool)
+	for _, key := range keys {
+		for _, t := range messages[key].Transformers() {
+			impl, ok := t.Impl.(localeTransformer)
+			if !ok || reported[t.Name] || impl.hasLocale(language) {
+				continue
+			}
+
+			reported[t.Name] = true
+			errs = append(errs, fmt.Errorf("%w in file %q: the %s transformer of message %q has no data for %s, the data of en is used", ErrMissingLocale, file, t.Name, key, language))
+		}
+	}
+
+	return errs
+}
+
 type ScopedContainer struct {
 	ctx context.Context
 	c   *Container
@@ -323,12 +775,73 @@ func (s *ScopedContainer) Message(key Key, replacements map[string]any) string {
 	return s.c.Message(s.ctx, key, replacements)
 }
 
+func (s *ScopedContainer) Format(key Key, replacements map[string]any) (string, error) {
+	return s.c.Format(s.ctx, key, replacements)
+}
+
 func WithDefaultLanguage(lang LanguageID) ContainerOpt {
 	return func(c *Container) {
 		c.defaultLanguage = lang
 	}
 }
 
+// WithPreferredRegion sets the region that is used for a language without an exact match when the language has no file
+// without region. For example, with en-GB and en-US files, WithPreferredRegion(MustParseLanguage("en-GB")) uses en-GB for en and en-AU.
+func WithPreferredRegion(lang LanguageID) ContainerOpt {
+	return func(c *Container) {
+		if c.preferredRegions == nil {
+			c.preferredRegions = make(map[string]string)
+		}
+
+		c.preferredRegions[lang.Language] = lang.Region
+	}
+}
+
+// WithFallback sets the languages that are used, in order, for a key that is missing in the language.
+// For example, WithFallback(MustParseLanguage("pt-BR"), MustParseLanguage("pt-PT")) uses the pt-PT message
+// for a key that has no pt-BR translation. The default language is always used as last fallback.
+// Without a fallback chain a language with a region falls back to the language without region, like nl-BE to nl.
+func WithFallback(lang LanguageID, fallbacks ...LanguageID) ContainerOpt {
+	return func(c *Container) {
+		if c.fallbackChains == nil {
+			c.fallbackChains = make(map[LanguageID][]LanguageID)
+		}
+
+		c.fallbackChains[lang] = fallbacks
+	}
+}
+
+// WithMissingReplacementPolicy sets how a replacement that is not provided and has no default transformer is formatted.
+func WithMissingReplacementPolicy(policy MissingReplacementPolicy) ContainerOpt {
+	return func(c *Container) {
+		c.missingReplacementPolicy = policy
+	}
+}
+
+// WithMissingReplacementDefault formats replacements that are not provided and have no default transformer as the value.
+// It sets the MissingReplacementPolicy to MissingReplacementDefault.
+func WithMissingReplacementDefault(value string) ContainerOpt {
+	return func(c *Container) {
+		c.missingReplacementPolicy = MissingReplacementDefault
+		c.missingReplacementDefault = value
+	}
+}
+
+// WithLenientLoad makes ContainerFromFs load all files and messages that are valid, instead of stopping at the first error.
+// The container is returned together with a LoadErrors error that holds the errors of all files and messages that are skipped.
+func WithLenientLoad() ContainerOpt {
+	return func(c *Container) {
+		c.lenient = true
+	}
+}
+
+// WithSyntax sets the syntax that is used to parse the messages of the container.
+func WithSyntax(syntax Syntax) ContainerOpt {
+	return func(c *Container) {
+		c.syntax = syntax
+	}
+}
+
 func formatReplacement(value any) string {
 	switch v := value.(type) {
 	case string:
@@ -339,6 +852,26 @@ func formatReplacement(value any) string {
 		return fmt.Sprintf("%.2f", v)
 	case bool:
 		return fmt.Sprintf("%t", v)
+	case time.Time:
+		// Use the date, time or datetime transformers to format a time in the format of the language.
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		if isNil(v) {
+			return ""
+		}
+
+		return v.String()
+	case encoding.TextMarshaler:
+		if isNil(v) {
+			return ""
+		}
+
+		text, err := v.MarshalText()
+		if err != nil {
+			return ""
+		}
+
+		return string(text)
 	}
 
 	valueOf := reflect.ValueOf(value)
@@ -370,8 +903,139 @@ func formatReplacement(value any) string {
 	return ""
 }
 
+// lookupReplacement returns the value of the replacement. A replacement with a path, like `:user.name`,
+// uses the full key if it is provided and otherwise navigates from the "user" replacement into the "name" map key or struct field.
+func lookupReplacement(replacements map[string]any, op parser.ReplacementOp) (any, bool) {
+	if value, ok := replacements[op.Key]; ok {
+		return value, true
+	}
+
+	path := op.Path()
+
+	value, ok := replacements[path[0]]
+	for _, name := range path[1:] {
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = lookupField(value, name)
+	}
+
+	return value, ok
+}
+
+// lookupField returns the value of the map key or exported struct field with the name.
+// A struct field matches the name of its `lingua:"name"` tag, or its own name ignoring the case, so `:user.name` uses the Name field.
+// Fields with the tag `lingua:"-"` are ignored.
+func lookupField(value any, name string) (any, bool) {
+	valueOf := reflect.ValueOf(value)
+	for valueOf.Kind() == reflect.Pointer || valueOf.Kind() == reflect.Interface {
+		if valueOf.IsNil() {
+			return nil, false
+		}
+
+		valueOf = valueOf.Elem()
+	}
+
+	switch valueOf.Kind() {
+	case reflect.Map:
+		if valueOf.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+
+		field := valueOf.MapIndex(reflect.ValueOf(name).Convert(valueOf.Type().Key()))
+		if !field.IsValid() {
+			return nil, false
+		}
+
+		return field.Interface(), true
+	case reflect.Struct:
+		field, ok := structField(valueOf.Type(), name)
+		if !ok {
+			return nil, false
+		}
+
+		// An embedded struct that is a nil pointer has no fields.
+		fieldOf, err := valueOf.FieldByIndexErr(field.Index)
+		if err != nil {
+			return nil, false
+		}
+
+		return fieldOf.Interface(), true
+	}
+
+	return nil, false
+}
+
+// structField returns the exported field of the struct type that matches the name.
+// A field with a matching tag is preferred over a field with a matching name.
+func structField(t reflect.Type, name string) (reflect.StructField, bool) {
+	var (
+		match reflect.StructField
+		found bool
+	)
+
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("lingua")
+		if tag == "-" {
+			continue
+		}
+
+		if ok && tag == name {
+			return field, true
+		}
+
+		if !found && (!ok || tag == "") && strings.EqualFold(field.Name, name) {
+			match, found = field, true
+		}
+	}
+
+	return match, found
+}
+
+// isNil returns true if the value is a nil pointer, which can not be used to call a method with a value receiver.
+func isNil(value any) bool {
+	valueOf := reflect.ValueOf(value)
+	return valueOf.Kind() == reflect.Pointer && valueOf.IsNil()
+}
+
 type ContainerOpt func(c *Container)
 
+// Syntax is the syntax of the messages in the translation files.
+type Syntax int
+
+const (
+	// SyntaxLingua is the default syntax with placeholders like ":name|capitalize".
+	SyntaxLingua Syntax = iota
+	// SyntaxICU is the ICU MessageFormat syntax with arguments like "{name}" or "{count, plural, other {#}}".
+	SyntaxICU
+)
+
+// ErrMissingReplacement is returned by Format if a replacement is missing and the policy is MissingReplacementError.
+var ErrMissingReplacement = errors.New("missing replacement")
+
+// ErrInvalidCurrency is returned by Format if the currency code of a currency transformer is not a valid ISO 4217 code.
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// MissingReplacementPolicy determines how a replacement that is not provided is formatted.
+// A replacement with a default transformer, like ":name|default(guest)", is never missing.
+type MissingReplacementPolicy int
+
+const (
+	// MissingReplacementPlaceholder leaves the placeholder, like ":name", in the message. This is the default.
+	MissingReplacementPlaceholder MissingReplacementPolicy = iota
+	// MissingReplacementEmpty formats the replacement as an empty string.
+	MissingReplacementEmpty
+	// MissingReplacementDefault formats the replacement as the value of WithMissingReplacementDefault.
+	MissingReplacementDefault
+	// MissingReplacementError leaves the placeholder in the message and makes Format return an ErrMissingReplacement.
+	MissingReplacementError
+)
+
 type MergeStrategy int
 
 const (
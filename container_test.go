@@ -184,3 +184,627 @@ func BenchmarkFormatPlural(b *testing.B) {
 		c.Message(ctx, "plural.test", map[string]any{"count": 4})
 	}
 }
+
+func TestContainerPluralCategories(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+apples: ":count|plural(=0 {no apples} one {# apple} other {# apples})"
+`)
+	mustWriteYaml(t, fs, "pl.yaml", `
+apples: ":count|plural(=0 {brak jabłek} one {# jabłko} few {# jabłka} many {# jabłek} other {# jabłka})"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	cases := []struct {
+		lang   string
+		count  any
+		expect string
+	}{
+		{lang: "en", count: 0, expect: "no apples"},
+		{lang: "en", count: 1, expect: "1 apple"},
+		{lang: "en", count: 2, expect: "2 apples"},
+		{lang: "pl", count: 0, expect: "brak jabłek"},
+		{lang: "pl", count: 1, expect: "1 jabłko"},
+		{lang: "pl", count: 3, expect: "3 jabłka"},
+		{lang: "pl", count: 5, expect: "5 jabłek"},
+		{lang: "pl", count: 22, expect: "22 jabłka"},
+		{lang: "pl", count: 25, expect: "25 jabłek"},
+	}
+
+	for _, tc := range cases {
+		ctx := WithLanguage(context.Background(), tc.lang)
+		require.Equal(t, tc.expect, c.Message(ctx, "apples", map[string]any{"count": tc.count}), "%s %v", tc.lang, tc.count)
+	}
+
+	// Raw keeps the category keywords.
+	require.Equal(t, ":count|plural(=0 {no apples} one {# apple} other {# apples})", c.Raw()[LanguageID{Language: "en"}]["apples"])
+}
+
+func TestContainerPluralDecimals(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+apples: ":count|plural(=0 {no apples} one {# apple} other {# apples})"
+items: ":count|plural(one {# item} other {# items})"
+formatted: ":count|number|plural(one {# item} other {# items})"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	cases := []struct {
+		key    Key
+		count  any
+		expect string
+	}{
+		{key: "apples", count: 2.5, expect: "2.5 apples"},
+		{key: "apples", count: 1.0, expect: "1 apple"},
+		{key: "apples", count: 0.0, expect: "no apples"},
+		{key: "apples", count: "1.50", expect: "1.50 apples"},
+		{key: "apples", count: "none", expect: "none apples"},
+		{key: "items", count: 2.5, expect: "2.5 items"},
+		{key: "items", count: float32(0.5), expect: "0.5 items"},
+		{key: "formatted", count: 1, expect: "1 item"},
+		{key: "formatted", count: 1234, expect: "1,234 items"},
+		{key: "formatted", count: 2.5, expect: "2.5 items"},
+	}
+
+	ctx := WithLanguage(context.Background(), "en")
+	for _, tc := range cases {
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, map[string]any{"count": tc.count}), "%s %v", tc.key, tc.count)
+	}
+}
+
+func TestContainerPluralNested(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+items: ":count|plural(=0 {:user|capitalize has no items} =1 {:user|capitalize has one item} other {:user|capitalize has :count|number items in :days|plural(one {# day} other {# days})})"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "John has no items", c.Message(ctx, "items", map[string]any{"count": 0, "user": "john"}))
+	require.Equal(t, "John has one item", c.Message(ctx, "items", map[string]any{"count": 1, "user": "john"}))
+	require.Equal(t, "John has 1,500 items in 3 days", c.Message(ctx, "items", map[string]any{"count": 1500, "user": "john", "days": 3}))
+
+	// A missing replacement in the case is left as placeholder.
+	require.Equal(t, ":user has one item", c.Message(ctx, "items", map[string]any{"count": 1}))
+
+	require.Equal(t, ":count|plural(=0 {:user|capitalize has no items} =1 {:user|capitalize has one item} other {:user|capitalize has :count|number items in :days|plural(one {# day} other {# days})})", c.Raw()[LanguageID{Language: "en"}]["items"])
+}
+
+func TestContainerOrdinal(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+place: ":place|ordinal(one {#st} two {#nd} few {#rd} other {#th}) place"
+`)
+	mustWriteYaml(t, fs, "nl.yaml", `
+place: ":place|ordinal(other {#e}) plaats"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	en := WithLanguage(context.Background(), "en")
+	for place, expect := range map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd", 23: "23rd", 101: "101st"} {
+		require.Equal(t, expect+" place", c.Message(en, "place", map[string]any{"place": place}))
+	}
+
+	nl := WithLanguage(context.Background(), "nl")
+	require.Equal(t, "3e plaats", c.Message(nl, "place", map[string]any{"place": 3}))
+
+	require.Equal(t, ":place|ordinal(one {#st} two {#nd} few {#rd} other {#th}) place", c.Raw()[LanguageID{Language: "en"}]["place"])
+}
+
+func TestContainerSelect(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+invite: ":gender|select(male {He} female {She} other {They}) invited :guest|select(admin {an administrator} other {:guest|capitalize})."
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "He invited John.", c.Message(ctx, "invite", map[string]any{"gender": "male", "guest": "john"}))
+	require.Equal(t, "She invited an administrator.", c.Message(ctx, "invite", map[string]any{"gender": "female", "guest": "admin"}))
+	require.Equal(t, "They invited John.", c.Message(ctx, "invite", map[string]any{"gender": "unknown", "guest": "john"}))
+}
+
+func TestContainerICUSyntax(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome {user}!"
+apples: "{gender, select, male {He has} other {They have}} {count, plural, =0 {no apples} one {# apple} other {# apples}}."
+`)
+
+	c, err := ContainerFromFs(fs, WithSyntax(SyntaxICU))
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Welcome john!", c.Message(ctx, "welcome", map[string]any{"user": "john"}))
+	require.Equal(t, "He has 1 apple.", c.Message(ctx, "apples", map[string]any{"gender": "male", "count": 1}))
+	require.Equal(t, "They have no apples.", c.Message(ctx, "apples", map[string]any{"gender": "unknown", "count": 0}))
+	require.Equal(t, "They have 3 apples.", c.Message(ctx, "apples", map[string]any{"gender": "female", "count": 3}))
+
+	raw := c.Raw()[LanguageID{Language: "en"}]
+	require.Equal(t, "Welcome {user}!", raw["welcome"])
+	require.Equal(t, "{gender, select, male {He has} other {They have}} {count, plural, =0 {no apples} one {# apple} other {# apples}}.", raw["apples"])
+}
+
+func TestContainerICUExport(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome {user}!"
+`)
+
+	to, err := ContainerFromFs(fs, WithSyntax(SyntaxICU))
+	require.NoError(t, err)
+
+	fs = afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+items: ":count|plural(=0 {none} =2-5 {a few} other {many})"
+total: ":total|number(decimals=2)"
+count: ":count|number"
+`)
+
+	from, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	c := Merge(from, to, Skip)
+
+	// Messages that ICU can not express are reported instead of written with another meaning,
+	// and are kept in the lingua syntax so no message is lost.
+	raw, err := c.Export()
+	require.EqualError(t, err, `unable to write message "items" of en in ICU: unable to write placeholder "count": ICU has no plural range =2-5
+unable to write message "total" of en in ICU: unable to write placeholder "total": unsupported argument "decimals=2" for number in ICU`)
+	require.Equal(t, map[string]string{
+		"welcome": "Welcome {user}!",
+		"count":   "{count, number}",
+		"items":   ":count|plural(=0 {none} =2-5 {a few} other {many})",
+		"total":   ":total|number(decimals=2)",
+	}, raw[LanguageID{Language: "en"}])
+	require.Equal(t, raw, c.Raw())
+}
+
+func TestContainerReferences(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+settings.title: "Settings of :user|capitalize"
+settings.link: "Go to @{settings.title}"
+items: ":count|plural(=0 {@{items.none}} other {# items})"
+items.none: "No items for :user"
+missing: "Go to @{unknown}"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Go to Settings of John", c.Message(ctx, "settings.link", map[string]any{"user": "john"}))
+	require.Equal(t, "No items for john", c.Message(ctx, "items", map[string]any{"user": "john", "count": 0}))
+	require.Equal(t, "Go to unknown", c.Message(ctx, "missing", nil))
+	require.Equal(t, "Go to @{settings.title}", c.Raw()[LanguageID{Language: "en"}]["settings.link"])
+}
+
+func TestContainerReferenceCycle(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+a: "A @{b}"
+b: ":gender|select(male {@{c}} other {B})"
+c: "C @{a}"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.ErrorContains(t, err, "message reference cycle a -> b -> c -> a")
+
+	mustWriteYaml(t, fs, "en.yaml", `
+self: "Self @{self}"
+`)
+
+	_, err = ContainerFromFs(fs)
+	require.ErrorContains(t, err, "message reference cycle self -> self")
+}
+
+func TestContainerDefault(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name|default(guest)|capitalize"
+empty: "Welcome :name|default"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.ErrorContains(t, err, "expected a single value for default transformer")
+
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name|default(guest)|capitalize"
+upper: "Welcome :name|upper|default(guest)"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Welcome Guest", c.Message(ctx, "welcome", nil))
+	require.Equal(t, "Welcome Guest", c.Message(ctx, "welcome", map[string]any{"name": nil}))
+	require.Equal(t, "Welcome John", c.Message(ctx, "welcome", map[string]any{"name": "john"}))
+
+	// The default does not have to be the first transformer.
+	require.Equal(t, "Welcome GUEST", c.Message(ctx, "upper", nil))
+	require.Equal(t, "Welcome GUEST", c.Message(ctx, "upper", map[string]any{"name": nil}))
+	require.Equal(t, "Welcome JOHN", c.Message(ctx, "upper", map[string]any{"name": "john"}))
+	require.Equal(t, "Welcome :name|default(guest)|capitalize", c.Raw()[LanguageID{Language: "en"}]["welcome"])
+}
+
+func TestContainerMissingReplacementPolicy(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name, you have :count messages"
+guest: "Welcome :name|default(guest)"
+`)
+
+	tests := []struct {
+		name     string
+		opts     []ContainerOpt
+		expected string
+	}{
+		{name: "placeholder", expected: "Welcome :name, you have 3 messages"},
+		{name: "empty", opts: []ContainerOpt{WithMissingReplacementPolicy(MissingReplacementEmpty)}, expected: "Welcome , you have 3 messages"},
+		{name: "default", opts: []ContainerOpt{WithMissingReplacementDefault("-")}, expected: "Welcome -, you have 3 messages"},
+		{name: "error", opts: []ContainerOpt{WithMissingReplacementPolicy(MissingReplacementError)}, expected: "Welcome :name, you have 3 messages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ContainerFromFs(fs, tt.opts...)
+			require.NoError(t, err)
+
+			ctx := WithLanguage(context.Background(), "en")
+			require.Equal(t, tt.expected, c.Message(ctx, "welcome", map[string]any{"count": 3}))
+			require.Equal(t, "Welcome guest", c.Message(ctx, "guest", nil))
+
+			_, err = c.Format(ctx, "guest", nil)
+			require.NoError(t, err)
+		})
+	}
+
+	c, err := ContainerFromFs(fs, WithMissingReplacementPolicy(MissingReplacementError))
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	message, err := c.Format(ctx, "welcome", map[string]any{"count": 3})
+	require.ErrorIs(t, err, ErrMissingReplacement)
+	require.EqualError(t, err, `missing replacement "name" in message "welcome"`)
+	require.Equal(t, "Welcome :name, you have 3 messages", message)
+
+	message, err = c.Format(ctx, "welcome", map[string]any{"name": "John", "count": 3})
+	require.NoError(t, err)
+	require.Equal(t, "Welcome John, you have 3 messages", message)
+}
+
+func TestContainerPlaceholderPath(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :user.name|capitalize from :user.address.city, :user_id."
+flat: "Welcome :user.name."
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+
+	user := map[string]any{
+		"name":    "john",
+		"address": map[string]string{"city": "Amsterdam"},
+	}
+	require.Equal(t, "Welcome John from Amsterdam, 42.", c.Message(ctx, "welcome", map[string]any{"user": user, "user_id": 42}))
+	require.Equal(t, "Welcome John from :user.address.city, :user_id.", c.Message(ctx, "welcome", map[string]any{"user": map[string]any{"name": "john"}}))
+
+	// A replacement with the full key is used before the path.
+	require.Equal(t, "Welcome Jane.", c.Message(ctx, "flat", map[string]any{"user.name": "Jane", "user": user}))
+}
+
+type testAddress struct {
+	City string
+}
+
+type testStatus int
+
+func (s testStatus) String() string {
+	return [...]string{"inactive", "active"}[s]
+}
+
+type testEmail string
+
+func (e testEmail) MarshalText() ([]byte, error) {
+	return []byte("<" + string(e) + ">"), nil
+}
+
+type testUser struct {
+	testAddress
+
+	Name     string
+	Nickname string     `lingua:"nick"`
+	Password string     `lingua:"-"`
+	Status   testStatus `lingua:"state"`
+	Email    testEmail
+	Manager  *testUser
+	Meta     map[string]any
+	secret   string
+}
+
+func TestContainerStructReplacements(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+user: ":user.name|capitalize (:user.nick) from :user.city is :user.state, mail :user.email"
+manager: "Manager :user.manager.name, role :user.meta.role"
+hidden: ":user.password :user.secret :user.nickname :user.manager.name"
+status: "Status :status"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+
+	user := testUser{
+		testAddress: testAddress{City: "Amsterdam"},
+		Name:        "john",
+		Nickname:    "jj",
+		Password:    "secret",
+		Status:      1,
+		Email:       "john@example.com",
+		Manager:     &testUser{Name: "Jane"},
+		Meta:        map[string]any{"role": "admin"},
+		secret:      "secret",
+	}
+
+	require.Equal(t, "John (jj) from Amsterdam is active, mail <john@example.com>", c.Message(ctx, "user", map[string]any{"user": user}))
+	require.Equal(t, "Manager Jane, role admin", c.Message(ctx, "manager", map[string]any{"user": &user}))
+	require.Equal(t, ":user.password :user.secret :user.nickname :user.manager.name", c.Message(ctx, "hidden", map[string]any{"user": testUser{}}))
+	require.Equal(t, "Status inactive", c.Message(ctx, "status", map[string]any{"status": testStatus(0)}))
+	require.Equal(t, "Status ", c.Message(ctx, "status", map[string]any{"status": (*testAddress)(nil)}))
+}
+
+func TestContainerDelimitedPlaceholder(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+place: "You finished :{place|ordinal(one {#st} two {#nd} few {#rd} other {#th})}!"
+escaped: "Use \\:name or \\@{key} for :count|plural(one {\\# #} other {\\{#\\}})"
+suffix: "x :{missing}y and :{missing}!"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "You finished 2nd!", c.Message(ctx, "place", map[string]any{"place": 2}))
+	require.Equal(t, "Use :name or @{key} for # 1", c.Message(ctx, "escaped", map[string]any{"count": 1}))
+	require.Equal(t, "Use :name or @{key} for {3}", c.Message(ctx, "escaped", map[string]any{"count": 3}))
+	require.Equal(t, `Use \:name or \@{key} for :count|plural(one {\# #} other {{#\}})`, c.Raw()[LanguageID{Language: "en"}]["escaped"])
+
+	// A missing placeholder keeps the delimiters if the text that follows needs them.
+	require.Equal(t, "x :{missing}y and :missing!", c.Message(ctx, "suffix", nil))
+}
+
+func TestContainerParseError(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name"
+broken:   "Hello :{name x}"
+`)
+
+	_, err := ContainerFromFs(fs)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, "en.yaml", parseErr.File)
+	require.Equal(t, Key("broken"), parseErr.Key)
+	require.Equal(t, "Hello :{name x}", parseErr.Message)
+	require.Equal(t, 3, parseErr.Line)
+	require.Equal(t, 11, parseErr.Column)
+	require.Equal(t, 12, parseErr.Offset)
+	require.Equal(t, "'}'", parseErr.Expected)
+	require.Equal(t, " ", parseErr.Found)
+	require.EqualError(t, err, `en.yaml:3:11: unable to parse message "broken": expected '}' after placeholder at position 12 (found " ")`)
+
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name|unknown"
+`)
+
+	_, err = ContainerFromFs(fs)
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 2, parseErr.Line)
+	require.Equal(t, 14, parseErr.Offset)
+	require.Equal(t, "unknown", parseErr.Found)
+	require.ErrorContains(t, err, `unknown transformer "unknown"`)
+}
+
+func TestContainerLenientLoad(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name"
+broken: "Hello :{name x}"
+unknown: "Hello :name|unknown"
+`)
+	mustWriteYaml(t, fs, "fr.yaml", `
+a: "@{b}"
+b: "@{a}"
+`)
+	mustWriteYaml(t, fs, "nl.yaml", `
+welcome: [invalid
+`)
+
+	_, err := ContainerFromFs(fs)
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, Key("broken"), parseErr.Key)
+
+	c, err := ContainerFromFs(fs, WithLenientLoad())
+	require.NotNil(t, c)
+
+	var loadErrs LoadErrors
+	require.ErrorAs(t, err, &loadErrs)
+	require.Len(t, loadErrs, 4)
+	require.ErrorContains(t, loadErrs[0], `unable to parse message "broken"`)
+	require.ErrorContains(t, loadErrs[1], `unable to parse message "unknown"`)
+	require.ErrorContains(t, loadErrs[2], `invalid file "fr.yaml": message reference cycle a -> b -> a`)
+	require.ErrorContains(t, loadErrs[3], `unable to decode yaml file "nl.yaml"`)
+	require.ErrorAs(t, err, &parseErr)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Welcome John", c.Message(ctx, "welcome", map[string]any{"name": "John"}))
+	require.Equal(t, "broken", c.Message(ctx, "broken", nil))
+}
+
+func TestContainerScopedLanguage(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en-US.yaml", `color: "color"`)
+	mustWriteYaml(t, fs, "en-GB.yaml", `color: "colour"`)
+	mustWriteYaml(t, fs, "nl.yaml", `color: "kleur"`)
+	mustWriteYaml(t, fs, "nl-BE.yaml", `color: "kleur (BE)"`)
+
+	tests := []struct {
+		name     string
+		opts     []ContainerOpt
+		lang     string
+		expected string
+	}{
+		{name: "exact", lang: "en-US", expected: "en-US"},
+		{name: "first region", lang: "en-AU", expected: "en-GB"},
+		{name: "first region without region", lang: "en", expected: "en-GB"},
+		{name: "preferred region", opts: []ContainerOpt{WithPreferredRegion(MustParseLanguage("en-US"))}, lang: "en-AU", expected: "en-US"},
+		{name: "plain language", opts: []ContainerOpt{WithPreferredRegion(MustParseLanguage("nl-BE"))}, lang: "nl-NL", expected: "nl"},
+		{name: "default", opts: []ContainerOpt{WithDefaultLanguage(MustParseLanguage("nl"))}, lang: "fr", expected: "nl"},
+		{name: "none", lang: "fr", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ContainerFromFs(fs, tt.opts...)
+			require.NoError(t, err)
+
+			ctx := WithLanguage(context.Background(), tt.lang)
+
+			// Resolve multiple times, because the result must not depend on the iteration order of the messages.
+			for range 10 {
+				require.Equal(t, tt.expected, c.ScopedLanguage(ctx).String())
+			}
+		})
+	}
+}
+
+func TestContainerFallback(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome"
+items: ":count|plural(one {# item} other {# items})"
+footer: "Powered by lingua"
+`)
+	mustWriteYaml(t, fs, "nl.yaml", `
+welcome: "Welkom"
+items: ":count|plural(one {# item} other {# items})"
+`)
+	mustWriteYaml(t, fs, "nl-BE.yaml", `
+title: "@{welcome} in België"
+`)
+	mustWriteYaml(t, fs, "pt-PT.yaml", `
+welcome: "Bem-vindo"
+`)
+	mustWriteYaml(t, fs, "pt-BR.yaml", `
+items: ":count|plural(one {# item} other {# itens})"
+`)
+
+	c, err := ContainerFromFs(fs,
+		WithDefaultLanguage(MustParseLanguage("en")),
+		WithFallback(MustParseLanguage("pt-BR"), MustParseLanguage("pt-PT")),
+	)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "nl-BE")
+	require.Equal(t, "Welkom", c.Message(ctx, "welcome", nil))
+	require.Equal(t, "Welkom in België", c.Message(ctx, "title", nil))
+	require.Equal(t, "Powered by lingua", c.Message(ctx, "footer", nil))
+	require.Equal(t, "unknown", c.Message(ctx, "unknown", nil))
+
+	ctx = WithLanguage(context.Background(), "pt-BR")
+	require.Equal(t, "Bem-vindo", c.Message(ctx, "welcome", nil))
+	require.Equal(t, "2 itens", c.Message(ctx, "items", map[string]any{"count": 2}))
+	require.Equal(t, "Powered by lingua", c.Message(ctx, "footer", nil))
+
+	// Without a fallback chain pt-BR has no language without region, so it falls back to the default language.
+	c, err = ContainerFromFs(fs, WithDefaultLanguage(MustParseLanguage("en")))
+	require.NoError(t, err)
+	require.Equal(t, "Welcome", c.Message(ctx, "welcome", nil))
+}
+
+func TestContainerScripts(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "zh-Hans.yaml", `
+hello: "你好"
+bye: "再见"
+`)
+	mustWriteYaml(t, fs, "zh-Hant-TW.yaml", `
+hello: "妳好"
+`)
+	mustWriteYaml(t, fs, "zh-Hant.yaml", `
+bye: "再見"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+	require.Len(t, c.Raw(), 3)
+
+	tests := []struct {
+		lang     string
+		expected string
+	}{
+		{lang: "zh-Hant-TW", expected: "zh-Hant-TW"},
+		{lang: "zh-Hant-HK", expected: "zh-Hant"},
+		{lang: "zh-TW", expected: "zh-Hant"},
+		{lang: "zh-CN", expected: "zh-Hans"},
+		{lang: "zh", expected: "zh-Hans"},
+	}
+
+	for _, tt := range tests {
+		ctx := WithLanguage(context.Background(), tt.lang)
+		require.Equal(t, tt.expected, c.ScopedLanguage(ctx).String(), tt.lang)
+	}
+
+	// A key that is missing in zh-Hant-TW falls back to zh-Hant.
+	ctx := WithLanguage(context.Background(), "zh-Hant-TW")
+	require.Equal(t, "再見", c.Message(ctx, "bye", nil))
+
+	// A language with a script is not used for a language with another likely script.
+	fs = afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "zh-Hans-CN.yaml", `
+hello: "你好"
+`)
+	mustWriteYaml(t, fs, "en.yaml", `
+hello: "Hello"
+`)
+
+	c, err = ContainerFromFs(fs, WithDefaultLanguage(MustParseLanguage("en")))
+	require.NoError(t, err)
+
+	tests = []struct {
+		lang     string
+		expected string
+	}{
+		{lang: "zh-TW", expected: "en"},
+		{lang: "zh-HK", expected: "en"},
+		{lang: "zh-Hant", expected: "en"},
+		{lang: "zh-SG", expected: "zh-Hans-CN"},
+		{lang: "zh", expected: "zh-Hans-CN"},
+		{lang: "en-GB", expected: "en"},
+	}
+
+	for _, tt := range tests {
+		ctx := WithLanguage(context.Background(), tt.lang)
+		require.Equal(t, tt.expected, c.ScopedLanguage(ctx).String(), tt.lang)
+	}
+}
@@ -0,0 +1,154 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SLASH2NL/lingua/internal/parser"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// currencyFormat describes the placement of the currency symbol for a language.
+type currencyFormat struct {
+	// suffix places the symbol after the amount instead of before it.
+	suffix bool
+	// space separates the symbol and the amount with a non-breaking space.
+	space bool
+}
+
+// currencyFormats holds the currency formats per language. Languages that are not listed use the format of en.
+var currencyFormats = map[string]currencyFormat{
+	"en": {},
+	"nl": {space: true},
+	"pt": {space: true},
+	"de": {suffix: true, space: true},
+	"fr": {suffix: true, space: true},
+	"es": {suffix: true, space: true},
+	"it": {suffix: true, space: true},
+	"pl": {suffix: true, space: true},
+}
+
+// formatCurrency formats the value as an amount in the currency with the ISO 4217 code.
+// The symbol placement and grouping of the language and the number of decimals of the currency are used.
+// Values that are not a number are returned as a formatted replacement.
+func formatCurrency(lang LanguageID, value any, code string) string {
+	n, ok := toNumber(value)
+	if !ok {
+		return formatReplacement(value)
+	}
+
+	p := message.NewPrinter(lang.Tag())
+
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		// Without a valid currency we can only format the number.
+		return p.Sprint(number.Decimal(n))
+	}
+
+	negative := false
+	switch v := n.(type) {
+	case int64:
+		negative = v < 0
+		if negative {
+			n = -v
+		}
+	case float64:
+		negative = v < 0
+		if negative {
+			n = -v
+		}
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+	amount := p.Sprint(number.Decimal(n, number.MinFractionDigits(scale), number.MaxFractionDigits(scale)))
+	symbol := p.Sprint(currency.Symbol(unit))
+
+	format, ok := currencyFormats[lang.Language]
+	if !ok {
+		format = currencyFormats["en"]
+	}
+
+	space := ""
+	if format.space {
+		space = "\u00a0"
+	}
+
+	sign := ""
+	if negative {
+		sign = "-"
+	}
+
+	switch {
+	case format.suffix:
+		// -12,50 €
+		return sign + amount + space + symbol
+	case format.space:
+		// € -12,50
+		return symbol + space + sign + amount
+	default:
+		// -€12.50
+		return sign + symbol + amount
+	}
+}
+
+// currencyTransformer formats the value as an amount of money.
+type currencyTransformer struct {
+	// code is the ISO 4217 currency code, like EUR.
+	code string
+
+	// key is the key of the replacement that holds the currency code. It is used if code is empty.
+	key string
+}
+
+// newCurrencyTransformer creates a currency transformer with a currency code like `EUR` or a replacement like `:currency`.
+func newCurrencyTransformer(args []string) (Transformer, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected a currency code or replacement for currency transformer")
+	}
+
+	// The currency can be provided by another replacement.
+	if key, ok := strings.CutPrefix(args[0], ":"); ok {
+		return currencyTransformer{key: key}, nil
+	}
+
+	if _, err := currency.ParseISO(args[0]); err != nil {
+		return nil, fmt.Errorf("invalid currency %q for currency transformer: %w", args[0], err)
+	}
+
+	return currencyTransformer{code: args[0]}, nil
+}
+
+func (t currencyTransformer) Transform(_ context.Context, lang LanguageID, value any, replacements map[string]any) any {
+	code, _ := t.lookupCode(replacements)
+	return formatCurrency(lang, value, code)
+}
+
+// transform formats the amount like Transform, and reports a missing or invalid currency code to the formatter.
+func (t currencyTransformer) transform(f *formatter, value any) any {
+	code, ok := t.lookupCode(f.replacements)
+	if !ok {
+		f.reportMissing(t.key)
+	} else if _, err := currency.ParseISO(code); err != nil {
+		f.fail(fmt.Errorf("%w %q of %q in message %q", ErrInvalidCurrency, code, t.key, f.references[0]))
+	}
+
+	return formatCurrency(f.lang, value, code)
+}
+
+// lookupCode returns the currency code, which is the value of the replacement with the key if the code is not fixed.
+// The key can be a path, like order.currency.
+func (t currencyTransformer) lookupCode(replacements map[string]any) (string, bool) {
+	if t.code != "" {
+		return t.code, true
+	}
+
+	code, ok := lookupReplacement(replacements, parser.ReplacementOp{Key: t.key})
+	if !ok || code == nil {
+		return "", false
+	}
+
+	return formatReplacement(code), true
+}
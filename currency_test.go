@@ -0,0 +1,87 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerCurrency(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, lang := range []string{"en-US", "nl", "de"} {
+		mustWriteYaml(t, fs, lang+".yaml", `
+owe: "You owe :amount|currency(EUR)"
+dynamic: ":amount|currency(:currency)"
+order: ":order.total|currency(:order.currency)"
+`)
+	}
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	cases := []struct {
+		lang         string
+		key          Key
+		replacements map[string]any
+		expect       string
+	}{
+		{lang: "en-US", key: "owe", replacements: map[string]any{"amount": 12.5}, expect: "You owe €12.50"},
+		{lang: "nl", key: "owe", replacements: map[string]any{"amount": 12.5}, expect: "You owe €\u00a012,50"},
+		{lang: "de", key: "owe", replacements: map[string]any{"amount": 1234.5}, expect: "You owe 1.234,50\u00a0€"},
+		{lang: "en-US", key: "owe", replacements: map[string]any{"amount": -12.5}, expect: "You owe -€12.50"},
+		{lang: "nl", key: "owe", replacements: map[string]any{"amount": -12.5}, expect: "You owe €\u00a0-12,50"},
+		{lang: "en-US", key: "dynamic", replacements: map[string]any{"amount": 1234, "currency": "JPY"}, expect: "¥1,234"},
+		{lang: "en-US", key: "dynamic", replacements: map[string]any{"amount": 10, "currency": "USD"}, expect: "$10.00"},
+		{lang: "en-US", key: "dynamic", replacements: map[string]any{"amount": 10, "currency": "invalid"}, expect: "10"},
+		{lang: "nl", key: "order", replacements: map[string]any{"order": map[string]any{"total": 10, "currency": "USD"}}, expect: "US$\u00a010,00"},
+		{lang: "en-US", key: "order", replacements: map[string]any{"order": struct{ Total, Currency any }{Total: 5, Currency: "GBP"}}, expect: "£5.00"},
+	}
+
+	for _, tc := range cases {
+		ctx := WithLanguage(context.Background(), tc.lang)
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, tc.replacements), "%s %s %v", tc.lang, tc.key, tc.replacements)
+	}
+
+	raw := c.Raw()[LanguageID{Language: "nl"}]
+	require.Equal(t, "You owe :amount|currency(EUR)", raw["owe"])
+	require.Equal(t, ":amount|currency(:currency)", raw["dynamic"])
+}
+
+func TestContainerCurrencyErrors(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+dynamic: "You owe :amount|currency(:currency)"
+`)
+
+	c, err := ContainerFromFs(fs, WithDefaultLanguage(MustParseLanguage("en")))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	message, err := c.Format(ctx, "dynamic", map[string]any{"amount": 10, "currency": "invalid"})
+	require.ErrorIs(t, err, ErrInvalidCurrency)
+	require.Equal(t, "You owe 10", message)
+
+	// A missing currency is reported like any missing replacement.
+	message, err = c.Format(ctx, "dynamic", map[string]any{"amount": 10})
+	require.NoError(t, err)
+	require.Equal(t, "You owe 10", message)
+
+	c, err = ContainerFromFs(fs, WithDefaultLanguage(MustParseLanguage("en")), WithMissingReplacementPolicy(MissingReplacementError))
+	require.NoError(t, err)
+
+	_, err = c.Format(ctx, "dynamic", map[string]any{"amount": 10})
+	require.ErrorIs(t, err, ErrMissingReplacement)
+}
+
+func TestContainerCurrencyInvalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+owe: "You owe :amount|currency(XXXX)"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.Error(t, err)
+}
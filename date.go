@@ -0,0 +1,313 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeZoneKey = ctxKey("lingua.timezone")
+
+// WithTimeZone adds the time zone to the ctx.
+// Dates and times are converted to this time zone before they are formatted.
+func WithTimeZone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, timeZoneKey, loc)
+}
+
+// TimeZoneFromCtx returns the time zone from the ctx or nil if no time zone is set.
+func TimeZoneFromCtx(ctx context.Context) *time.Location {
+	loc, _ := ctx.Value(timeZoneKey).(*time.Location)
+	return loc
+}
+
+type dateKind int
+
+const (
+	dateKindDate dateKind = iota
+	dateKindTime
+	dateKindDateTime
+)
+
+type dateStyle int
+
+const (
+	dateStyleFull dateStyle = iota
+	dateStyleLong
+	dateStyleMedium
+	dateStyleShort
+)
+
+var dateStyles = map[string]dateStyle{
+	"full":   dateStyleFull,
+	"long":   dateStyleLong,
+	"medium": dateStyleMedium,
+	"short":  dateStyleShort,
+}
+
+// dateTransformer formats a time.Time value as a date, a time or both in the format of the language.
+type dateTransformer struct {
+	kind  dateKind
+	style dateStyle
+
+	// pattern is a custom CLDR date pattern like "yyyy-MM-dd". It takes priority over the style.
+	pattern string
+
+	// skeleton is a CLDR skeleton like "yMMMd" that is converted to the pattern of the language.
+	// It takes priority over the pattern and style.
+	skeleton string
+}
+
+// newDateTransformer returns the factory of the date, time or datetime transformer.
+// The transformer accepts a single style like `short`, a skeleton like `::yMMMd` or a pattern like `"yyyy-MM-dd"`.
+func newDateTransformer(kind dateKind) TransformerFactory {
+	return func(args []string) (Transformer, error) {
+		t := dateTransformer{
+			kind:  kind,
+			style: dateStyleMedium,
+		}
+
+		if len(args) > 1 {
+			return nil, fmt.Errorf("expected a single style, pattern or skeleton for date transformer")
+		}
+
+		if len(args) == 0 {
+			return t, nil
+		}
+
+		if skeleton, ok := strings.CutPrefix(args[0], "::"); ok {
+			// Every locale has the same skeletons, so the en skeletons are the supported skeletons.
+			if _, ok := dateLocales["en"].skeletons[skeleton]; !ok {
+				return nil, fmt.Errorf("unknown skeleton %q for date transformer, expected one of %q", skeleton, slices.Sorted(maps.Keys(dateLocales["en"].skeletons)))
+			}
+
+			t.skeleton = skeleton
+			return t, nil
+		}
+
+		if style, ok := dateStyles[args[0]]; ok {
+			t.style = style
+			return t, nil
+		}
+
+		t.pattern = args[0]
+
+		return t, nil
+	}
+}
+
+func (t dateTransformer) Transform(ctx context.Context, lang LanguageID, value any, _ map[string]any) any {
+	return formatDate(lang, TimeZoneFromCtx(ctx), value, t)
+}
+
+func (t dateTransformer) hasLocale(lang LanguageID) bool {
+	_, ok := dateLocaleFor(lang)
+	return ok
+}
+
+// dateLocale holds the CLDR gregorian calendar data of a language.
+type dateLocale struct {
+	months      [12]string
+	shortMonths [12]string
+	days        [7]string
+	shortDays   [7]string
+	am, pm      string
+
+	// standaloneMonths are the month names without a day, like in "LLLL y". They are only set if they differ from the months.
+	standaloneMonths [12]string
+
+	// The date, time and date time formats are indexed by dateStyle.
+	// The date time formats combine the date {1} and the time {0}.
+	dateFormats     [4]string
+	timeFormats     [4]string
+	dateTimeFormats [4]string
+
+	// skeletons maps CLDR skeletons to the patterns of the language.
+	skeletons map[string]string
+}
+
+// dateLocaleFor returns the date data of the language, the language without region or en.
+// The ok is false if en is used for another language.
+func dateLocaleFor(lang LanguageID) (locale *dateLocale, ok bool) {
+	if l, ok := dateLocales[lang.String()]; ok {
+		return l, true
+	}
+
+	if l, ok := dateLocales[lang.Language]; ok {
+		return l, true
+	}
+
+	return dateLocales["en"], false
+}
+
+// formatDate formats a time.Time value as a date, time or both in the format of the language.
+// If loc is not nil the time is converted to the location first.
+// Values that are not a time are returned as a formatted replacement.
+func formatDate(lang LanguageID, loc *time.Location, value any, t dateTransformer) string {
+	var tm time.Time
+
+	switch v := value.(type) {
+	case time.Time:
+		tm = v
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+
+		tm = *v
+	default:
+		return formatReplacement(value)
+	}
+
+	if loc != nil {
+		tm = tm.In(loc)
+	}
+
+	locale, _ := dateLocaleFor(lang)
+
+	return locale.format(tm, locale.pattern(t))
+}
+
+// pattern returns the CLDR pattern to use for the transformer.
+func (l *dateLocale) pattern(t dateTransformer) string {
+	if t.skeleton != "" {
+		// The skeleton is checked by newDateTransformer.
+		return l.skeletons[t.skeleton]
+	}
+
+	if t.pattern != "" {
+		return t.pattern
+	}
+
+	switch t.kind {
+	case dateKindTime:
+		return l.timeFormats[t.style]
+	case dateKindDateTime:
+		// The date time format combines the date and time patterns into a single pattern.
+		r := strings.NewReplacer("{1}", l.dateFormats[t.style], "{0}", l.timeFormats[t.style])
+		return r.Replace(l.dateTimeFormats[t.style])
+	}
+
+	return l.dateFormats[t.style]
+}
+
+// format formats the time with a CLDR date pattern.
+// Letters are pattern fields, text between single quotes is a literal and two single quotes are a quote.
+func (l *dateLocale) format(t time.Time, pattern string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		// Quoted literal text.
+		if c == '\'' {
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				b.WriteByte('\'')
+				i += 2
+				continue
+			}
+
+			end := strings.IndexByte(pattern[i+1:], '\'')
+			if end == -1 {
+				b.WriteString(pattern[i+1:])
+				break
+			}
+
+			b.WriteString(pattern[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		// Count the length of the field, like MMMM.
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+		i += n
+
+		l.field(&b, t, c, n)
+	}
+
+	return b.String()
+}
+
+// field writes a single pattern field of length n.
+func (l *dateLocale) field(b *strings.Builder, t time.Time, c byte, n int) {
+	switch c {
+	case 'y':
+		if n == 2 {
+			writePadded(b, t.Year()%100, 2)
+		} else {
+			writePadded(b, t.Year(), n)
+		}
+	case 'M', 'L':
+		switch {
+		case n >= 4 && c == 'L' && l.standaloneMonths[0] != "":
+			b.WriteString(l.standaloneMonths[t.Month()-1])
+		case n >= 4:
+			b.WriteString(l.months[t.Month()-1])
+		case n == 3:
+			b.WriteString(l.shortMonths[t.Month()-1])
+		default:
+			writePadded(b, int(t.Month()), n)
+		}
+	case 'd':
+		writePadded(b, t.Day(), n)
+	case 'E':
+		if n >= 4 {
+			b.WriteString(l.days[t.Weekday()])
+		} else {
+			b.WriteString(l.shortDays[t.Weekday()])
+		}
+	case 'a':
+		if t.Hour() < 12 {
+			b.WriteString(l.am)
+		} else {
+			b.WriteString(l.pm)
+		}
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+
+		writePadded(b, hour, n)
+	case 'H':
+		writePadded(b, t.Hour(), n)
+	case 'm':
+		writePadded(b, t.Minute(), n)
+	case 's':
+		writePadded(b, t.Second(), n)
+	case 'z':
+		if n >= 4 {
+			b.WriteString(t.Location().String())
+		} else {
+			name, _ := t.Zone()
+			b.WriteString(name)
+		}
+	case 'Z':
+		b.WriteString(t.Format("-0700"))
+	default:
+		// Unsupported fields are written as-is.
+		b.WriteString(strings.Repeat(string(c), n))
+	}
+}
+
+// writePadded writes the number with leading zeros up to the width.
+func writePadded(b *strings.Builder, v int, width int) {
+	s := strconv.Itoa(v)
+	for i := len(s); i < width; i++ {
+		b.WriteByte('0')
+	}
+
+	b.WriteString(s)
+}
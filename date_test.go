@@ -0,0 +1,119 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerDate(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, lang := range []string{"en-US", "en-GB", "nl", "de", "pl", "sv"} {
+		mustWriteYaml(t, fs, lang+".yaml", `
+date: ":at|date"
+short: ":at|date(short)"
+full: ":at|date(full)"
+time: ":at|time(short)"
+datetime: ":at|datetime(long)"
+pattern: ':at|date("yyyy-MM-dd HH:mm")'
+skeleton: ":at|date(::yMMMEd)"
+month: ":at|date(::yMMMM)"
+`)
+	}
+
+	// sv has no date data, so it is formatted with the data of en.
+	c, err := ContainerFromFs(fs, WithLenientLoad())
+	require.ErrorIs(t, err, ErrMissingLocale)
+
+	var loadErrs LoadErrors
+	require.ErrorAs(t, err, &loadErrs)
+	require.Len(t, loadErrs, 3)
+	require.EqualError(t, loadErrs[0], `missing locale data in file "sv.yaml": the date transformer of message "date" has no data for sv, the data of en is used`)
+
+	_, err = ContainerFromFs(fs)
+	require.ErrorIs(t, err, ErrMissingLocale)
+
+	at := time.Date(2025, time.March, 7, 14, 5, 9, 0, time.UTC)
+
+	cases := []struct {
+		lang   string
+		key    Key
+		expect string
+	}{
+		{lang: "en-US", key: "date", expect: "Mar 7, 2025"},
+		{lang: "en-US", key: "short", expect: "3/7/25"},
+		{lang: "en-US", key: "full", expect: "Friday, March 7, 2025"},
+		{lang: "en-US", key: "time", expect: "2:05 PM"},
+		{lang: "en-US", key: "datetime", expect: "March 7, 2025 at 2:05:09 PM UTC"},
+		{lang: "en-US", key: "pattern", expect: "2025-03-07 14:05"},
+		{lang: "en-US", key: "skeleton", expect: "Fri, Mar 7, 2025"},
+		{lang: "en-GB", key: "short", expect: "07/03/2025"},
+		{lang: "en-GB", key: "time", expect: "14:05"},
+		{lang: "nl", key: "date", expect: "7 mrt. 2025"},
+		{lang: "nl", key: "full", expect: "vrijdag 7 maart 2025"},
+		{lang: "nl", key: "short", expect: "07-03-2025"},
+		{lang: "nl", key: "datetime", expect: "7 maart 2025 om 14:05:09 UTC"},
+		{lang: "de", key: "full", expect: "Freitag, 7. März 2025"},
+		{lang: "de", key: "skeleton", expect: "Fr., 7. März 2025"},
+		{lang: "pl", key: "full", expect: "piątek, 7 marca 2025"},
+		{lang: "pl", key: "short", expect: "7.03.2025"},
+		{lang: "pl", key: "month", expect: "marzec 2025"},
+		{lang: "pl", key: "datetime", expect: "7 marca 2025 o 14:05:09 UTC"},
+		// Languages without date data use en.
+		{lang: "sv", key: "full", expect: "Friday, March 7, 2025"},
+	}
+
+	for _, tc := range cases {
+		ctx := WithLanguage(context.Background(), tc.lang)
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, map[string]any{"at": at}), "%s %s", tc.lang, tc.key)
+	}
+
+	raw := c.Raw()[LanguageID{Language: "nl"}]
+	require.Equal(t, ":at|date", raw["date"])
+	require.Equal(t, ":at|date(short)", raw["short"])
+	require.Equal(t, `:at|date("yyyy-MM-dd HH:mm")`, raw["pattern"])
+	require.Equal(t, ":at|date(::yMMMEd)", raw["skeleton"])
+}
+
+func TestContainerDateTimeZone(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "nl.yaml", `
+time: ":at|time(short)"
+plain: ":at"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+
+	at := time.Date(2025, time.March, 7, 14, 5, 9, 0, time.UTC)
+
+	ctx := WithLanguage(context.Background(), "nl")
+	require.Equal(t, "14:05", c.Message(ctx, "time", map[string]any{"at": at}))
+	require.Equal(t, "15:05", c.Message(WithTimeZone(ctx, amsterdam), "time", map[string]any{"at": at}))
+
+	// A time without transformer is not localized, but it is not empty.
+	require.Equal(t, "2025-03-07T14:05:09Z", c.Message(ctx, "plain", map[string]any{"at": at}))
+}
+
+func TestContainerDateInvalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+date: ":at|date(short, long)"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.Error(t, err)
+
+	mustWriteYaml(t, fs, "en.yaml", `
+date: ":at|date(::yMMMMEEEEd)"
+`)
+
+	_, err = ContainerFromFs(fs)
+	require.ErrorContains(t, err, `unknown skeleton "yMMMMEEEEd" for date transformer`)
+}
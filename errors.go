@@ -0,0 +1,90 @@
+package lingua
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SLASH2NL/lingua/internal/parser"
+)
+
+// ParseError is returned by ContainerFromFs if a message in a translation file can not be parsed.
+type ParseError struct {
+	// File is the name of the translation file.
+	File string
+
+	// Key is the key of the message.
+	Key Key
+
+	// Message is the raw message that can not be parsed.
+	Message string
+
+	// Line and Column are the position of the message in the file, starting at 1.
+	Line   int
+	Column int
+
+	// Offset is the position in bytes in the message where the error was found, or -1 if the position is unknown.
+	Offset int
+
+	// Expected is the text that was expected, like "'}'". It is empty if the error is not about missing text.
+	Expected string
+
+	// Found is the text that was found at the offset. It is empty at the end of the message.
+	Found string
+
+	// Err is the error of the parser.
+	Err error
+}
+
+// newParseError returns a ParseError with the offset, expected and found text of the parser error.
+func newParseError(file string, key Key, message string, line, column int, err error) *ParseError {
+	parseErr := &ParseError{
+		File:    file,
+		Key:     key,
+		Message: message,
+		Line:    line,
+		Column:  column,
+		Offset:  -1,
+		Err:     err,
+	}
+
+	var syntaxErr *parser.Error
+	if errors.As(err, &syntaxErr) {
+		parseErr.Offset = syntaxErr.Offset
+		parseErr.Expected = syntaxErr.Expected
+		parseErr.Found = syntaxErr.Found
+	}
+
+	return parseErr
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: unable to parse message %q: %s", e.File, e.Line, e.Column, e.Key, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ErrMissingLocale is returned by ContainerFromFs if a message uses a transformer that has no locale data for the
+// language of the message, like a date in a language that is not supported. The message is formatted with the locale
+// data of en. Use WithLenientLoad to load the container anyway.
+var ErrMissingLocale = errors.New("missing locale data")
+
+// LoadErrors holds the errors of all files and messages that are skipped by ContainerFromFs when the container is
+// loaded with WithLenientLoad, and the ErrMissingLocale errors of messages that are loaded.
+// Use errors.As to find the ParseError of a message.
+type LoadErrors []error
+
+func (e LoadErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+func (e LoadErrors) Unwrap() []error {
+	return e
+}
@@ -0,0 +1,33 @@
+package parser
+
+import "fmt"
+
+// Error is an error at a position in a message, like a syntax error or a transformer that can not be resolved.
+type Error struct {
+	// Msg describes the error, like "expected '}' after placeholder".
+	Msg string
+
+	// Offset is the position in bytes in the message where the error was found.
+	Offset int
+
+	// Expected is the text that was expected, like "'}'". It is empty if the error is not about missing text.
+	Expected string
+
+	// Found is the text that was found at the offset. It is empty at the end of the message.
+	Found string
+
+	// Err is the error of the Resolver if the transformer can not be resolved.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Found == "" {
+		return fmt.Sprintf("%s at position %d", e.Msg, e.Offset)
+	}
+
+	return fmt.Sprintf("%s at position %d (found %q)", e.Msg, e.Offset, e.Found)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,633 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+)
+
+// ParseICU parses a message in the ICU MessageFormat syntax, for example:
+//
+//	{count, plural, =0 {No apples} one {# apple} other {# apples}}
+//
+// The result contains the same ops as Parse, so both syntaxes can be formatted the same way.
+func ParseICU(input string, opts ...Option) (*Message, error) {
+	p := &icuParser{input: input, options: newOptions(opts)}
+
+	ops, err := p.parseMessage(false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{Ops: ops}, nil
+}
+
+type icuParser struct {
+	*options
+
+	input string // the string being parsed
+	pos   int    // current position in the input
+}
+
+// parseMessage parses literals and arguments until the end of the input.
+// If nested is true the message is the body of a case and ends with a '}'.
+// If inPlural is true the message is part of a plural case and '#' is parsed as a PluralCountOp.
+func (p *icuParser) parseMessage(nested bool, inPlural bool) ([]any, error) {
+	ops := make([]any, 0)
+
+	var lit strings.Builder
+	collect := func() {
+		if lit.Len() == 0 {
+			return
+		}
+
+		ops = append(ops, LiteralOp{Value: lit.String()})
+		lit.Reset()
+	}
+
+	for {
+		switch r := p.peek(); {
+		case r == eof:
+			if nested {
+				return nil, p.error("'}'", "unexpected EOF, expected '}'")
+			}
+
+			collect()
+			return ops, nil
+		case r == '}':
+			if !nested {
+				return nil, p.error("", "unexpected '}'")
+			}
+
+			p.next() // Collect the '}'
+			collect()
+			return ops, nil
+		case r == '{':
+			collect()
+
+			op, err := p.parseArgument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+
+			ops = append(ops, op)
+		case r == '#' && inPlural:
+			collect()
+
+			p.next() // Collect the '#'
+			ops = append(ops, PluralCountOp{})
+		case r == '\'':
+			p.next() // Collect the '\''
+
+			switch n := p.peek(); {
+			case n == '\'':
+				// Two apostrophes are an escaped apostrophe.
+				p.next()
+				lit.WriteRune('\'')
+			case n == '{' || n == '}' || (n == '#' && inPlural):
+				// Quoted literal text, which ends at the next single apostrophe.
+				for {
+					q := p.next()
+					if q == eof {
+						break
+					}
+
+					if q == '\'' {
+						if p.peek() != '\'' {
+							break
+						}
+
+						p.next()
+					}
+
+					lit.WriteRune(q)
+				}
+			default:
+				lit.WriteRune('\'')
+			}
+		default:
+			lit.WriteRune(p.next())
+		}
+	}
+}
+
+// parseArgument parses an argument like {name}, {count, plural, ...} or {gender, select, ...}.
+func (p *icuParser) parseArgument(inPlural bool) (any, error) {
+	p.next() // Collect the '{'
+	p.skipSpaces()
+
+	name := p.argumentName()
+	if name == "" {
+		return nil, p.error("argument name", "expected argument name")
+	}
+
+	op := ReplacementOp{Key: name}
+
+	p.skipSpaces()
+	if p.accept('}') {
+		return op, nil
+	}
+
+	if !p.accept(',') {
+		if r := p.peek(); r == '-' || r == '.' || strings.ContainsRune(digits, r) {
+			return nil, p.error("", fmt.Sprintf("invalid character %q in argument name", r))
+		}
+
+		return nil, p.error("',' or '}'", "expected ',' or '}' after argument name")
+	}
+
+	p.skipSpaces()
+	argType := p.identifier()
+	p.skipSpaces()
+
+	switch argType {
+	case "number":
+		name, args := "number", []string(nil)
+
+		if p.accept(',') {
+			p.skipSpaces()
+
+			var err error
+			name, args, err = p.numberStyle()
+			if err != nil {
+				return nil, err
+			}
+
+			p.skipSpaces()
+		}
+
+		if !p.accept('}') {
+			return nil, p.error("'}'", "expected '}' after number")
+		}
+
+		t, err := p.transformer(name, args)
+		if err != nil {
+			return nil, p.transformerError(err)
+		}
+
+		op.Transformers = append(op.Transformers, t)
+
+		return op, nil
+	case "date", "time":
+		var args []string
+
+		if p.accept(',') {
+			p.skipSpaces()
+
+			// The style can be a date style, a skeleton or a pattern, like the argument of the lingua transformer.
+			style := p.style()
+			if style == "" {
+				return nil, p.error("style", fmt.Sprintf("expected %s style", argType))
+			}
+
+			args = append(args, style)
+		}
+
+		if !p.accept('}') {
+			return nil, p.error("'}'", fmt.Sprintf("expected '}' after %s", argType))
+		}
+
+		t, err := p.transformer(argType, args)
+		if err != nil {
+			return nil, p.transformerError(err)
+		}
+
+		op.Transformers = append(op.Transformers, t)
+
+		return op, nil
+	case "plural", "selectordinal":
+		if !p.accept(',') {
+			return nil, p.error("','", fmt.Sprintf("expected ',' after %s", argType))
+		}
+
+		cases, other, err := p.parsePluralCases()
+		if err != nil {
+			return nil, err
+		}
+
+		if argType == "plural" {
+			op.Transformers = append(op.Transformers, PluralTransformer{Cases: cases, Other: other})
+		} else {
+			op.Transformers = append(op.Transformers, OrdinalTransformer{Cases: cases, Other: other})
+		}
+
+		return op, nil
+	case "select":
+		if !p.accept(',') {
+			return nil, p.error("','", "expected ',' after select")
+		}
+
+		sel, err := p.parseSelectCases(inPlural)
+		if err != nil {
+			return nil, err
+		}
+
+		op.Transformers = append(op.Transformers, sel)
+
+		return op, nil
+	case "":
+		return nil, p.error("argument type", "expected argument type")
+	default:
+		return nil, p.error("", fmt.Sprintf("unsupported argument type %q", argType))
+	}
+}
+
+func (p *icuParser) parsePluralCases() (cases []PluralCase, other []any, err error) {
+	cases = make([]PluralCase, 0)
+
+	for {
+		p.skipSpaces()
+		if p.accept('}') {
+			break
+		}
+
+		pcase := PluralCase{Type: OpPluralCaseTypeExact}
+
+		if p.accept('=') {
+			start := p.pos
+			for p.peek() >= '0' && p.peek() <= '9' {
+				p.next()
+			}
+
+			pcase.A, err = strconv.Atoi(p.input[start:p.pos])
+			if err != nil {
+				return nil, nil, p.error("number", "expected number after '='")
+			}
+		} else {
+			switch selector := p.identifier(); selector {
+			case "other":
+				pcase.Type = OpPluralCaseOther
+			case "zero", "one", "two", "few", "many":
+				pcase.Type = OpPluralCaseCategory
+				pcase.Category = selector
+			case "":
+				if p.peek() == eof {
+					return nil, nil, p.error("", "unexpected EOF")
+				}
+
+				return nil, nil, p.error("plural selector", "expected plural selector")
+			default:
+				return nil, nil, p.error("", fmt.Sprintf("unsupported plural selector %q", selector))
+			}
+		}
+
+		p.skipSpaces()
+		if !p.accept('{') {
+			return nil, nil, p.error("'{'", "expected '{' for plural case start")
+		}
+
+		pcase.Ops, err = p.parseMessage(true, true)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if pcase.Type == OpPluralCaseOther {
+			other = pcase.Ops
+			continue
+		}
+
+		cases = append(cases, pcase)
+	}
+
+	if other == nil {
+		return nil, nil, p.error("'other' case", "missing 'other' case")
+	}
+
+	return cases, other, nil
+}
+
+func (p *icuParser) parseSelectCases(inPlural bool) (sel SelectTransformer, err error) {
+	sel.Cases = make([]SelectCase, 0)
+
+	for {
+		p.skipSpaces()
+		if p.accept('}') {
+			break
+		}
+
+		value := p.identifier()
+		if value == "" {
+			if p.peek() == eof {
+				return sel, p.error("", "unexpected EOF")
+			}
+
+			return sel, p.error("select case value", "expected select case value")
+		}
+
+		p.skipSpaces()
+		if !p.accept('{') {
+			return sel, p.error("'{'", "expected '{' for select case start")
+		}
+
+		ops, err := p.parseMessage(true, inPlural)
+		if err != nil {
+			return sel, err
+		}
+
+		if value == "other" {
+			sel.Other = ops
+			continue
+		}
+
+		sel.Cases = append(sel.Cases, SelectCase{Value: value, Ops: ops})
+	}
+
+	if sel.Other == nil {
+		return sel, p.error("'other' case", "missing 'other' case")
+	}
+
+	return sel, nil
+}
+
+// identifier collects a run of letters, digits, underscores, dashes and dots, like an argument type or a select value.
+func (p *icuParser) identifier() string {
+	start := p.pos
+	for strings.ContainsRune(lowercase+uppercase+digits+"_-.", p.peek()) {
+		p.next()
+	}
+
+	return p.input[start:p.pos]
+}
+
+// argumentName collects an argument name or a path like `{user.name}`. Like a lingua placeholder, every part starts
+// with a letter or underscore and contains only letters, digits and underscores, so the message can be written in both
+// syntaxes.
+func (p *icuParser) argumentName() string {
+	start := p.pos
+	for strings.ContainsRune(identifierStart, p.peek()) {
+		for strings.ContainsRune(identifierStart+digits, p.peek()) {
+			p.next()
+		}
+
+		if p.peek() != '.' {
+			break
+		}
+
+		// Collect the '.', a part must follow it.
+		p.next()
+		if !strings.ContainsRune(identifierStart, p.peek()) {
+			p.pos--
+			break
+		}
+	}
+
+	return p.input[start:p.pos]
+}
+
+// numberStyle parses the style of a number argument into the name and arguments of the number or currency transformer.
+func (p *icuParser) numberStyle() (string, []string, error) {
+	style := p.style()
+	if code, ok := strings.CutPrefix(style, "::currency/"); ok {
+		if _, err := currency.ParseISO(code); err != nil {
+			return "", nil, p.error("", fmt.Sprintf("invalid currency %q", code))
+		}
+
+		return "currency", []string{code}, nil
+	}
+
+	switch style {
+	case "integer", "percent":
+		return "number", []string{style}, nil
+	case "::compact-short":
+		return "number", []string{"compact"}, nil
+	default:
+		return "", nil, p.error("", fmt.Sprintf("unsupported number style %q", style))
+	}
+}
+
+// style collects an argument style like "integer", a pattern like "EEEE d MMMM" or a skeleton like "::compact-short".
+func (p *icuParser) style() string {
+	start := p.pos
+	for r := p.peek(); r != eof && r != '}'; r = p.peek() {
+		p.next()
+	}
+
+	return strings.TrimSpace(p.input[start:p.pos])
+}
+
+func (p *icuParser) skipSpaces() {
+	for strings.ContainsRune(spaces, p.peek()) {
+		p.next()
+	}
+}
+
+// accept consumes the next rune if it is r.
+func (p *icuParser) accept(r rune) bool {
+	if p.peek() != r {
+		return false
+	}
+
+	p.next()
+	return true
+}
+
+func (p *icuParser) next() rune {
+	if p.pos >= len(p.input) {
+		return eof
+	}
+
+	r, w := utf8.DecodeRuneInString(p.input[p.pos:])
+	p.pos += w
+	return r
+}
+
+func (p *icuParser) peek() rune {
+	if p.pos >= len(p.input) {
+		return eof
+	}
+
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+	return r
+}
+
+// error returns an error at the current position. The expected is empty if the error is not about missing text.
+func (p *icuParser) error(expected, msg string) error {
+	found := ""
+	if r := p.peek(); r != eof {
+		found = string(r)
+	}
+
+	return &Error{Msg: msg, Offset: p.pos, Expected: expected, Found: found}
+}
+
+// transformerError returns the error of the Resolver at the current position.
+func (p *icuParser) transformerError(err error) error {
+	return &Error{Msg: err.Error(), Offset: p.pos, Err: err}
+}
+
+// RawICU returns the message in the ICU MessageFormat syntax.
+// It returns an error if the message can not be written in ICU without changing its meaning,
+// like a plural range, a chain of transformers or a transformer that has no ICU equivalent.
+func (m Message) RawICU() (string, error) {
+	var b strings.Builder
+	if err := writeICUOps(&b, m.Ops, false); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func writeICUOps(b *strings.Builder, ops []any, inPlural bool) error {
+	for _, op := range ops {
+		switch v := op.(type) {
+		case LiteralOp:
+			writeICULiteral(b, v.Value, inPlural)
+		case PluralCountOp:
+			b.WriteRune('#')
+		case ReferenceOp:
+			return fmt.Errorf("unable to write reference @{%s}: ICU has no message references", v.Key)
+		case ReplacementOp:
+			b.WriteRune('{')
+			b.WriteString(v.Key)
+			if err := writeICUTransformer(b, v.Transformers, inPlural); err != nil {
+				return fmt.Errorf("unable to write placeholder %q: %w", v.Key, err)
+			}
+			b.WriteRune('}')
+		}
+	}
+
+	return nil
+}
+
+// writeICUTransformer writes the transformer as ICU argument type and style.
+// ICU has a single argument type per placeholder, so a chain of transformers can not be written.
+func writeICUTransformer(b *strings.Builder, transformers []any, inPlural bool) error {
+	if len(transformers) == 0 {
+		return nil
+	}
+
+	if len(transformers) > 1 {
+		return fmt.Errorf("ICU has no chained transformers")
+	}
+
+	switch t := transformers[0].(type) {
+	case Transformer:
+		return writeICUArgument(b, t)
+	case PluralTransformer:
+		b.WriteString(", plural,")
+		return writeICUPluralCases(b, t.Cases, t.Other)
+	case OrdinalTransformer:
+		b.WriteString(", selectordinal,")
+		return writeICUPluralCases(b, t.Cases, t.Other)
+	case SelectTransformer:
+		b.WriteString(", select,")
+		for _, c := range t.Cases {
+			b.WriteString(" " + c.Value + " {")
+			if err := writeICUOps(b, c.Ops, inPlural); err != nil {
+				return err
+			}
+			b.WriteRune('}')
+		}
+
+		b.WriteString(" other {")
+		if err := writeICUOps(b, t.Other, inPlural); err != nil {
+			return err
+		}
+		b.WriteRune('}')
+	}
+
+	return nil
+}
+
+// writeICUArgument writes the argument type and style of a transformer.
+// It returns an error if the transformer or its arguments have no ICU equivalent.
+func writeICUArgument(b *strings.Builder, t Transformer) error {
+	switch t.Name {
+	case "date", "time":
+		if len(t.Args) > 1 {
+			return fmt.Errorf("unsupported arguments %q for %s in ICU", t.Args, t.Name)
+		}
+
+		b.WriteString(", " + t.Name)
+
+		if len(t.Args) == 1 && t.Args[0] != "medium" {
+			b.WriteString(", " + t.Args[0])
+		}
+	case "currency":
+		if len(t.Args) != 1 || strings.HasPrefix(t.Args[0], ":") {
+			return fmt.Errorf("ICU can not read the currency from another placeholder")
+		}
+
+		b.WriteString(", number, ::currency/" + t.Args[0])
+	case "number":
+		if len(t.Args) > 1 {
+			return fmt.Errorf("unsupported arguments %q for number in ICU", t.Args)
+		}
+
+		b.WriteString(", number")
+
+		if len(t.Args) == 0 {
+			return nil
+		}
+
+		switch t.Args[0] {
+		case "percent":
+			b.WriteString(", percent")
+		case "compact":
+			b.WriteString(", ::compact-short")
+		case "integer", "decimals=0":
+			b.WriteString(", integer")
+		default:
+			return fmt.Errorf("unsupported argument %q for number in ICU", t.Args[0])
+		}
+	default:
+		return fmt.Errorf("transformer %q has no ICU argument type", t.Name)
+	}
+
+	return nil
+}
+
+func writeICUPluralCases(b *strings.Builder, cases []PluralCase, other []any) error {
+	for _, c := range cases {
+		b.WriteRune(' ')
+
+		switch {
+		case c.Type == OpPluralCaseCategory:
+			b.WriteString(c.Category)
+		case c.Type == OpPluralCaseTypeRange && c.A != c.B:
+			return fmt.Errorf("ICU has no plural range =%d-%d", c.A, c.B)
+		default:
+			b.WriteRune('=')
+			b.WriteString(strconv.Itoa(c.A))
+		}
+
+		b.WriteString(" {")
+		if err := writeICUOps(b, c.Ops, true); err != nil {
+			return err
+		}
+		b.WriteRune('}')
+	}
+
+	b.WriteString(" other {")
+	if err := writeICUOps(b, other, true); err != nil {
+		return err
+	}
+	b.WriteRune('}')
+
+	return nil
+}
+
+// writeICULiteral writes the literal and quotes the characters that have a special meaning in ICU.
+// All text from the first up to the last special character is quoted: "{literal}" is written as "'{literal}'".
+func writeICULiteral(b *strings.Builder, value string, inPlural bool) {
+	isSpecial := func(r rune) bool {
+		return r == '{' || r == '}' || (r == '#' && inPlural)
+	}
+
+	first := strings.IndexFunc(value, isSpecial)
+	if first == -1 {
+		b.WriteString(strings.ReplaceAll(value, "'", "''"))
+		return
+	}
+
+	last := strings.LastIndexFunc(value, isSpecial) + 1
+
+	b.WriteString(strings.ReplaceAll(value[:first], "'", "''"))
+	b.WriteRune('\'')
+	b.WriteString(strings.ReplaceAll(value[first:last], "'", "''"))
+	b.WriteRune('\'')
+	b.WriteString(strings.ReplaceAll(value[last:], "'", "''"))
+}
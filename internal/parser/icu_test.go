@@ -0,0 +1,188 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseICU(t *testing.T) {
+	source := "I have {count, plural, =0 {no apples} one {# apple} other {# apples from {name}}} and {gender, select, male {he} female {she} other {they}} has {total, number}."
+
+	message, err := ParseICU(source)
+	require.NoError(t, err)
+
+	for _, op := range message.Ops {
+		t.Logf("Op: %T %+v", op, op)
+	}
+
+	require.Len(t, message.Ops, 7)
+	require.Equal(t, LiteralOp{Value: "I have "}, message.Ops[0])
+
+	replacement, ok := message.Ops[1].(ReplacementOp)
+	require.True(t, ok)
+	require.Equal(t, "count", replacement.Key)
+
+	plural, ok := replacement.Transformers[0].(PluralTransformer)
+	require.True(t, ok)
+	require.Len(t, plural.Cases, 2)
+	require.Equal(t, OpPluralCaseTypeExact, plural.Cases[0].Type)
+	require.Equal(t, "one", plural.Cases[1].Category)
+	require.Equal(t, []any{PluralCountOp{}, LiteralOp{Value: " apples from "}, ReplacementOp{Key: "name"}}, plural.Other)
+
+	replacement, ok = message.Ops[3].(ReplacementOp)
+	require.True(t, ok)
+
+	sel, ok := replacement.Transformers[0].(SelectTransformer)
+	require.True(t, ok)
+	require.Len(t, sel.Cases, 2)
+	require.Equal(t, []any{LiteralOp{Value: "they"}}, sel.Other)
+
+	replacement, ok = message.Ops[5].(ReplacementOp)
+	require.True(t, ok)
+	require.Equal(t, []any{Transformer{Name: "number"}}, replacement.Transformers)
+
+	raw, err := message.RawICU()
+	require.NoError(t, err)
+	require.Equal(t, source, raw)
+}
+
+func TestParseICUQuoting(t *testing.T) {
+	source := "It''s '{literal}' and {count, plural, other {# is not '#'}}"
+
+	message, err := ParseICU(source)
+	require.NoError(t, err)
+
+	require.Equal(t, LiteralOp{Value: "It's {literal} and "}, message.Ops[0])
+	raw, err := message.RawICU()
+	require.NoError(t, err)
+	require.Equal(t, source, raw)
+
+	// An apostrophe that does not quote a special character is a literal.
+	message, err = ParseICU("It's {name}")
+	require.NoError(t, err)
+	require.Equal(t, LiteralOp{Value: "It's "}, message.Ops[0])
+}
+
+func TestParseICUErrors(t *testing.T) {
+	for _, source := range []string{
+		"{name",
+		"{}",
+		"unbalanced }",
+		"{count, plural, one {# apple}}",
+		"{count, plural, other {# apples}",
+		"{gender, select, male {he}}",
+		"{date, duration}",
+		"{user-name}",
+		"{0}",
+		"{user.}",
+		"{user.1st}",
+	} {
+		_, err := ParseICU(source)
+		require.Error(t, err, source)
+	}
+
+	// A dash can not be part of a lingua placeholder, so it is not accepted in an argument name.
+	_, err := ParseICU("Hello {user-name}")
+	require.ErrorContains(t, err, `invalid character '-' in argument name at position 11`)
+}
+
+func TestParseICUNumberStyles(t *testing.T) {
+	for source, expect := range map[string]any{
+		"{n, number, integer}":         Transformer{Name: "number", Args: []string{"integer"}},
+		"{n, number, percent}":         Transformer{Name: "number", Args: []string{"percent"}},
+		"{n, number, ::compact-short}": Transformer{Name: "number", Args: []string{"compact"}},
+		"{n, number, ::currency/EUR}":  Transformer{Name: "currency", Args: []string{"EUR"}},
+	} {
+		message, err := ParseICU(source)
+		require.NoError(t, err, source)
+		require.Equal(t, []any{ReplacementOp{Key: "n", Transformers: []any{expect}}}, message.Ops)
+		raw, err := message.RawICU()
+		require.NoError(t, err)
+		require.Equal(t, source, raw)
+	}
+
+	_, err := ParseICU("{n, number, ::currency/XX}")
+	require.Error(t, err)
+}
+
+func TestParseICUDate(t *testing.T) {
+	for source, expect := range map[string]any{
+		"{d, date}":                Transformer{Name: "date"},
+		"{d, date, short}":         Transformer{Name: "date", Args: []string{"short"}},
+		"{d, time, full}":          Transformer{Name: "time", Args: []string{"full"}},
+		"{d, date, ::yMMMd}":       Transformer{Name: "date", Args: []string{"::yMMMd"}},
+		"{d, date, EEEE d MMMM y}": Transformer{Name: "date", Args: []string{"EEEE d MMMM y"}},
+	} {
+		message, err := ParseICU(source)
+		require.NoError(t, err, source)
+		require.Equal(t, []any{ReplacementOp{Key: "d", Transformers: []any{expect}}}, message.Ops)
+		raw, err := message.RawICU()
+		require.NoError(t, err)
+		require.Equal(t, source, raw)
+	}
+}
+
+func TestParseICUResolver(t *testing.T) {
+	resolve := func(name string, args []string) (any, error) {
+		if name != "number" {
+			return nil, fmt.Errorf("unknown transformer %q", name)
+		}
+
+		return strings.Join(args, ","), nil
+	}
+
+	message, err := ParseICU("{n, number, percent}", WithResolver(resolve))
+	require.NoError(t, err)
+	require.Equal(t, []any{ReplacementOp{Key: "n", Transformers: []any{Transformer{Name: "number", Args: []string{"percent"}, Impl: "percent"}}}}, message.Ops)
+
+	_, err = ParseICU("{d, date}", WithResolver(resolve))
+	require.ErrorContains(t, err, `unknown transformer "date"`)
+}
+
+func TestParseICUPlaceholderPath(t *testing.T) {
+	source := "Welcome {user.first_name} from {user.address.city}"
+
+	message, err := ParseICU(source)
+	require.NoError(t, err)
+
+	require.Equal(t, ReplacementOp{Key: "user.first_name"}, message.Ops[1])
+	require.Equal(t, ReplacementOp{Key: "user.address.city"}, message.Ops[3])
+	raw, err := message.RawICU()
+	require.NoError(t, err)
+	require.Equal(t, source, raw)
+}
+
+func TestRawICUUnsupported(t *testing.T) {
+	for source, expect := range map[string]string{
+		":n|plural(=2 {two} other {# items})": "{n, plural, =2 {two} other {# items}}",
+		":n|number(decimals=0)":               "{n, number, integer}",
+		":d|date(short)":                      "{d, date, short}",
+	} {
+		message, err := Parse(source)
+		require.NoError(t, err, source)
+
+		raw, err := message.RawICU()
+		require.NoError(t, err, source)
+		require.Equal(t, expect, raw)
+	}
+
+	for source, expect := range map[string]string{
+		":n|plural(=2-5 {few} other {many})": `unable to write placeholder "n": ICU has no plural range =2-5`,
+		":n|number(decimals=2)":              `unable to write placeholder "n": unsupported argument "decimals=2" for number in ICU`,
+		":n|number(percent, decimals=1)":     `unable to write placeholder "n": unsupported arguments ["percent" "decimals=1"] for number in ICU`,
+		":p|currency(:code)":                 `unable to write placeholder "p": ICU can not read the currency from another placeholder`,
+		":name|capitalize":                   `unable to write placeholder "name": transformer "capitalize" has no ICU argument type`,
+		":name|upper|default(guest)":         `unable to write placeholder "name": ICU has no chained transformers`,
+		"See @{other.key}":                   `unable to write reference @{other.key}: ICU has no message references`,
+		":n|plural(other {:d|datetime})":     `unable to write placeholder "n": unable to write placeholder "d": transformer "datetime" has no ICU argument type`,
+	} {
+		message, err := Parse(source)
+		require.NoError(t, err, source)
+
+		_, err = message.RawICU()
+		require.EqualError(t, err, expect, source)
+	}
+}
@@ -2,11 +2,8 @@ package parser
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"unicode/utf8"
-
-	"golang.org/x/term"
 )
 
 type tokenType int8
@@ -17,18 +14,31 @@ const (
 	literal tokenType = iota
 	replacement
 	transformer
+	transformerArg
 	pluralNumeric
 	pluralRange
 	pluralOther
-	pluralTranslationStart
-	pluralTranslationEnd
+	pluralCategory
+	selectCase
+	caseStart
+	caseEnd
 	pluralCount
+	reference
 	errTok
 
 	lowercase = "abcdefghijklmnopqrstuvwxyz"
 	uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	digits    = "0123456789"
 	spaces    = " \t\n "
+
+	// escapable are the characters that are escaped with a backslash in a literal, like `\:name` or `\#`.
+	escapable = ":|{}#@\\"
+
+	// identifierStart are the characters a placeholder name or a part of its path can start with.
+	identifierStart = lowercase + uppercase + "_"
+
+	// argDelimiters can not be part of a transformer argument.
+	argDelimiters = "(){}|\""
 )
 
 func runLexer(input string) ([]Token, error) {
@@ -41,9 +51,8 @@ func runLexer(input string) ([]Token, error) {
 		state = state(l)
 	}
 
-	// Check if the last state was an error.
-	if len(l.tokens) > 0 && l.tokens[len(l.tokens)-1].TokenType == errTok {
-		return nil, fmt.Errorf("lexer error: %s", l.tokens[len(l.tokens)-1].Data)
+	if l.err != nil {
+		return nil, l.err
 	}
 
 	return l.tokens, nil
@@ -52,23 +61,53 @@ func runLexer(input string) ([]Token, error) {
 func lexLiteral(l *lexer) lexerStateFn {
 	for {
 		if l.peek() == eof {
+			if len(l.cases) > 0 {
+				l.error("'}'", "unexpected EOF, expected '}'")
+				return nil
+			}
+
 			l.collect(literal)
 			return nil
 		}
 
+		// Check if we reached the end of a case body of a transformer like select.
+		if l.peek() == '}' && len(l.cases) > 0 {
+			l.collect(literal)
+
+			l.next() // Collect the '}'
+			l.collect(caseEnd)
+
+			// Continue with the state of the transformer that started the case.
+			c := l.cases[len(l.cases)-1]
+			l.cases = l.cases[:len(l.cases)-1]
+
+			return c.next
+		}
+
+		// Inside a plural case, including the cases nested in it, the '#' is replaced by the count.
+		if l.peek() == '#' && l.inPlural() {
+			l.collect(literal)
+
+			l.next() // Collect the '#'
+			l.collect(pluralCount)
+
+			continue
+		}
+
 		if l.peek() == ':' {
 			l.collect(literal)
 			return lexerPlaceholder
 		}
 
-		// Check if we are dealing with an escape character that escapes the : sign.
+		if strings.HasPrefix(l.input[l.pos:], "@{") {
+			l.collect(literal)
+			return lexerReference
+		}
+
+		// Check if we are dealing with an escape character, like `\:`, the escaped character is part of the literal.
 		if l.peek() == '\\' {
 			l.next()
-
-			// Check if the next character is a : sign, if so we ignore it.
-			if l.peek() == ':' {
-				l.next()
-			}
+			l.accept(escapable)
 
 			continue
 		}
@@ -77,18 +116,38 @@ func lexLiteral(l *lexer) lexerStateFn {
 	}
 }
 
+// lexerPlaceholder lexes a placeholder like `:name`, `:user_name`, `:firstName`, `:item2` or `:user.name`.
+// The name starts with a letter or underscore and can contain letters, digits and underscores.
+// Dots separate the name into a path, a dot that is not followed by a letter or underscore ends the name.
+// A placeholder can be delimited by braces, like `:{name}th` or `:{name|capitalize}th`, to separate it from the text that follows.
 func lexerPlaceholder(l *lexer) lexerStateFn {
 	l.next() // Collect the ':'
 
-	if !l.accept(lowercase) {
+	delimited := l.peek() == '{' && strings.ContainsRune(identifierStart, l.peekAt(1))
+	if delimited {
+		l.next() // Collect the '{'
+		l.delimited = append(l.delimited, len(l.cases))
+	}
+
+	if !l.accept(identifierStart) {
 		// We are not dealing with a placeholder but a normal : sign.
 		return lexLiteral
 	}
 
 	l.backup()
-	l.ignore() // Ignore the ':'
+	l.ignore() // Ignore the ':' and '{'
+
+	for {
+		l.acceptRun(identifierStart + digits)
+
+		// Only continue with the path if the dot is followed by a name, so a sentence can end with a placeholder.
+		if !strings.HasPrefix(l.input[l.pos:], ".") || !strings.ContainsRune(identifierStart, l.peekAt(1)) {
+			break
+		}
+
+		l.next() // Collect the '.'
+	}
 
-	l.acceptRun(lowercase)
 	l.collect(replacement)
 
 	// Check if we need to lex a transformer.
@@ -96,12 +155,59 @@ func lexerPlaceholder(l *lexer) lexerStateFn {
 		return lexerTransformer
 	}
 
+	return lexerPlaceholderEnd
+}
+
+// lexerPlaceholderEnd lexes the '}' that closes a delimited placeholder like `:{name}`.
+func lexerPlaceholderEnd(l *lexer) lexerStateFn {
+	// Only the placeholder that was opened in the current case body is closed.
+	if len(l.delimited) == 0 || l.delimited[len(l.delimited)-1] != len(l.cases) {
+		return lexLiteral
+	}
+
+	if l.peek() != '}' {
+		l.next()
+		l.error("'}'", "expected '}' after placeholder")
+		return nil
+	}
+
+	l.next() // Collect the '}'
+	l.ignore()
+	l.delimited = l.delimited[:len(l.delimited)-1]
+
+	return lexLiteral
+}
+
+// lexerReference lexes a reference to another message like `@{settings.title}`.
+func lexerReference(l *lexer) lexerStateFn {
+	l.next() // Collect the '@'
+	l.next() // Collect the '{'
+	l.ignore()
+
+	l.acceptRun(lowercase + uppercase + digits + "._-")
+	if l.pos == l.start {
+		l.next()
+		l.error("message key", "expected message key in reference")
+		return nil
+	}
+
+	l.collect(reference)
+
+	if l.peek() != '}' {
+		l.next()
+		l.error("'}'", "expected '}' after message key in reference")
+		return nil
+	}
+
+	l.next() // Collect the '}'
+	l.ignore()
+
 	return lexLiteral
 }
 
 func lexerTransformer(l *lexer) lexerStateFn {
 	if l.peek() != '|' {
-		return lexLiteral
+		return lexerPlaceholderEnd
 	}
 
 	l.next() // Collect the '|' and ignore it.
@@ -110,7 +216,7 @@ func lexerTransformer(l *lexer) lexerStateFn {
 	if !l.accept(lowercase) {
 		// Lex the invalid character.
 		l.next()
-		l.error("expected lowercase transformer name")
+		l.error("transformer name", "expected lowercase transformer name")
 		return nil
 	}
 
@@ -120,9 +226,9 @@ func lexerTransformer(l *lexer) lexerStateFn {
 	transformerType := l.data()
 
 	switch transformerType {
-	case "plural":
+	case "plural", "ordinal":
 		if l.peek() != '(' {
-			l.error("expected '(' after plural transformer")
+			l.error("'('", fmt.Sprintf("expected '(' after %s transformer", transformerType))
 			return nil
 		}
 
@@ -130,16 +236,69 @@ func lexerTransformer(l *lexer) lexerStateFn {
 		l.next() // Collect the '('
 
 		return lexerPluralArgs
-	case "capitalize", "replace":
+	case "select":
+		if l.peek() != '(' {
+			l.error("'('", "expected '(' after select transformer")
+			return nil
+		}
+
 		l.collect(transformer)
+		l.next() // Collect the '('
+
+		return lexerSelectArgs
+	default:
+		l.collect(transformer)
+
+		// The arguments are optional.
+		if l.peek() == '(' {
+			l.next() // Collect the '('
+			l.ignore()
+
+			return lexerTransformerArgs
+		}
 
 		// We can chain transformers, so we need to check if there is another transformer.
 		return lexerTransformer
-	default:
-		l.error("unknown transformer")
 	}
+}
 
-	return nil
+// lexerTransformerArgs lexes a comma separated list of arguments like `(percent, decimals=2)`.
+// Arguments that contain spaces or delimiters can be quoted: `("EEEE d MMMM")`.
+func lexerTransformerArgs(l *lexer) lexerStateFn {
+	for {
+		// Ignore all whitespace characters and commas between args.
+		l.acceptRun(spaces + ",")
+		l.ignore()
+
+		switch r := l.peek(); {
+		case r == ')':
+			l.next() // Collect the ')'
+			l.ignore()
+
+			// We can chain transformers, so we need to check if there is another transformer.
+			return lexerTransformer
+		case r == eof:
+			l.error("')'", "unexpected EOF, expected ')'")
+			return nil
+		case r == '"':
+			if !l.quoted() {
+				return nil
+			}
+
+			l.collect(transformerArg)
+			continue
+		case strings.ContainsRune(argDelimiters, r):
+			l.next()
+			l.error("", fmt.Sprintf("unexpected %q in transformer arguments", r))
+			return nil
+		}
+
+		for r := l.peek(); r != eof && !strings.ContainsRune(spaces+","+argDelimiters, r); r = l.peek() {
+			l.next()
+		}
+
+		l.collect(transformerArg)
+	}
 }
 
 func lexerPluralArgs(l *lexer) lexerStateFn {
@@ -163,23 +322,82 @@ func lexerPluralArgs(l *lexer) lexerStateFn {
 			return lexerPluralNumericArg
 		}
 
-		// Check if we are dealing with 'other'.
-		if strings.HasPrefix(l.input[l.pos:], "other") {
-			for i := 0; i < 5; i++ {
+		// Check if we are dealing with 'other' or one of the other CLDR plural categories.
+		if category := l.pluralCategory(); category != "" {
+			for range category {
 				l.next()
 			}
 
-			l.collect(pluralOther)
+			if category == "other" {
+				l.collect(pluralOther)
+			} else {
+				l.collect(pluralCategory)
+			}
+
 			return lexerPluralTranslation
 		}
 
-		x := l.next()
-		if x == eof {
-			l.error("unexpected EOF")
+		// Report an unknown selector as a whole word, like 'fwe' instead of 'few'.
+		if !l.accept(lowercase + uppercase + digits + "_-") {
+			if l.next() == eof {
+				l.error("", "unexpected EOF")
+			} else {
+				l.error("plural selector", "expected plural selector")
+			}
+
 			return nil
 		}
 
+		l.acceptRun(lowercase + uppercase + digits + "_-")
+		l.error("plural selector", "expected plural selector")
+
+		return nil
+	}
+}
+
+func lexerSelectArgs(l *lexer) lexerStateFn {
+	// Ignore all whitespace characters between args.
+	l.acceptRun(spaces)
+	l.ignore()
+
+	// There are no more arguments.
+	if l.peek() == ')' {
+		l.next() // Collect the ')'
+		l.ignore()
+
+		// We can chain transformers, so we need to check if there is another transformer.
+		return lexerTransformer
+	}
+
+	if !l.accept(lowercase + uppercase + digits + "_-") {
+		if l.next() == eof {
+			l.error("", "unexpected EOF")
+		} else {
+			l.error("select case value", "expected select case value")
+		}
+
+		return nil
 	}
+
+	l.acceptRun(lowercase + uppercase + digits + "_-")
+	l.collect(selectCase)
+
+	l.acceptRun(spaces)
+	l.ignore()
+
+	if l.peek() != '{' {
+		l.error("'{'", "expected '{' for select case start")
+		return nil
+	}
+
+	l.next() // Collect the '{'
+	l.collect(caseStart)
+
+	// The case body is a message on its own, so it can contain replacements with transformers.
+	// After the closing '}' the lexer continues with the next select case.
+	l.cases = append(l.cases, lexerCase{next: lexerSelectArgs})
+
+	return lexLiteral
 }
 
 func lexerPluralNumericArg(l *lexer) lexerStateFn {
@@ -188,7 +406,7 @@ func lexerPluralNumericArg(l *lexer) lexerStateFn {
 
 	// Expect a number (of at least 1 digit).
 	if !l.accept(digits) {
-		l.error("expected number after '='")
+		l.error("number", "expected number after '='")
 		return nil
 	}
 	l.acceptRun(digits) // Collect the rest of the digits.
@@ -201,7 +419,7 @@ func lexerPluralNumericArg(l *lexer) lexerStateFn {
 
 		// Expect a number (of at least 1 digit).
 		if !l.accept(digits) {
-			l.error("expected number after '-'")
+			l.error("number", "expected number after '-'")
 			return nil
 		}
 		l.acceptRun(digits) // Collect the rest of the digits.
@@ -218,41 +436,59 @@ func lexerPluralTranslation(l *lexer) lexerStateFn {
 	l.ignore()
 
 	if l.peek() != '{' {
-		l.error("expected '{' for plural translation start")
+		l.error("'{'", "expected '{' for plural translation start")
 		return nil
 	}
 
 	l.next() // Collect the '{'
-	l.collect(pluralTranslationStart)
+	l.collect(caseStart)
 
-	// Collect the translation.
-	for {
-		// Check if we have reached the end of the translation.
-		if l.peek() == '}' {
-			// Collect the current buffer as a literal.
-			l.collect(literal)
+	// The translation is a message on its own, so it can contain replacements with transformers.
+	// After the closing '}' the lexer continues with the next plural argument.
+	l.cases = append(l.cases, lexerCase{next: lexerPluralArgs, plural: true})
 
-			l.next() // Collect the '}'
-			l.collect(pluralTranslationEnd)
+	return lexLiteral
+}
 
-			// Continue and try to parse the next plural argument.
-			return lexerPluralArgs
-		}
+// quoted consumes a double quoted string, where a quote can be escaped with a backslash.
+// It returns false and lexes an error if the string is not terminated.
+func (l *lexer) quoted() bool {
+	l.next() // Collect the opening '"'
 
-		n := l.next()
-		if n == eof {
-			l.error("unexpected EOF")
-			return nil
+	for {
+		switch l.next() {
+		case eof:
+			l.error("'\"'", "unexpected EOF, expected '\"'")
+			return false
+		case '\\':
+			l.next() // Collect the escaped character.
+		case '"':
+			return true
 		}
+	}
+}
 
-		if n == '#' {
-			l.backup()
-			l.collect(literal)
+// pluralCategories are the CLDR plural categories that can be used as a plural case.
+var pluralCategories = []string{"zero", "one", "two", "few", "many", "other"}
 
-			l.next()
-			l.collect(pluralCount)
+// pluralCategory returns the CLDR plural category at the current position or an empty string.
+func (l *lexer) pluralCategory() string {
+	rest := l.input[l.pos:]
+
+	for _, category := range pluralCategories {
+		if !strings.HasPrefix(rest, category) {
+			continue
 		}
+
+		// Make sure we matched the whole word and not the prefix of another word.
+		if len(rest) > len(category) && strings.ContainsRune(lowercase+uppercase, rune(rest[len(category)])) {
+			continue
+		}
+
+		return category
 	}
+
+	return ""
 }
 
 type lexer struct {
@@ -261,10 +497,39 @@ type lexer struct {
 	pos    int     // current position in the input
 	width  int     // width of last rune read from input
 	tokens []Token // slice of tokens
+
+	// cases holds the cases, like a select case, of which the body is being lexed.
+	cases []lexerCase
+
+	// err is the error that stopped the lexer.
+	err *Error
+
+	// delimited holds the number of cases at the start of each delimited placeholder, like `:{name}`, that is being lexed.
+	delimited []int
 }
 
 type lexerStateFn func(*lexer) lexerStateFn
 
+// lexerCase is the body of a case of a transformer like plural or select.
+type lexerCase struct {
+	// next is the state to continue with after the body is lexed.
+	next lexerStateFn
+
+	// plural is true for the case of a plural or ordinal transformer.
+	plural bool
+}
+
+// inPlural returns true if the body of a plural case is being lexed.
+func (l *lexer) inPlural() bool {
+	for _, c := range l.cases {
+		if c.plural {
+			return true
+		}
+	}
+
+	return false
+}
+
 // collect the current data as a new token on the tokens slice.
 func (l *lexer) collect(t tokenType) {
 	if l.start == l.pos {
@@ -274,6 +539,7 @@ func (l *lexer) collect(t tokenType) {
 	l.tokens = append(l.tokens, Token{
 		TokenType: t,
 		Data:      l.input[l.start:l.pos],
+		Pos:       l.start,
 	})
 	l.start = l.pos
 }
@@ -282,21 +548,19 @@ func (l *lexer) data() string {
 	return l.input[l.start:l.pos]
 }
 
-func (l *lexer) error(msg string) {
-	dataSample := l.input[0:l.pos]
-
-	isTerminal := term.IsTerminal(int(os.Stdout.Fd()))
-	if isTerminal {
-		if len(dataSample) > 0 {
-			dataSample = fmt.Sprintf("%s\033[4m\033[1;31m%s\033[0m", dataSample[:l.start], dataSample[l.start:l.pos])
-		}
+// error stops the lexer with an error at the current token. The expected is empty if the error is not about missing text.
+func (l *lexer) error(expected, msg string) {
+	l.err = &Error{
+		Msg:      msg,
+		Offset:   l.start,
+		Expected: expected,
+		Found:    l.input[l.start:l.pos],
 	}
 
-	errorMsg := fmt.Sprintf("%s at position %d (%s)", msg, l.pos, dataSample)
-
 	l.tokens = append(l.tokens, Token{
 		TokenType: errTok,
-		Data:      errorMsg,
+		Data:      msg,
+		Pos:       l.start,
 	})
 	l.start = l.pos
 }
@@ -319,6 +583,34 @@ func (l *lexer) peek() rune {
 	return r
 }
 
+// unescape removes the backslashes of the escaped characters in a literal.
+func unescape(s string) string {
+	if !strings.ContainsRune(s, '\\') {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(escapable, s[i+1]) >= 0 {
+			i++
+		}
+
+		b.WriteByte(s[i])
+	}
+
+	return b.String()
+}
+
+// peekAt returns the rune at the offset in bytes from the current position without consuming it.
+func (l *lexer) peekAt(offset int) rune {
+	if l.pos+offset >= len(l.input) {
+		return eof
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.input[l.pos+offset:])
+	return r
+}
+
 func (l *lexer) ignore() {
 	l.start = l.pos
 }
@@ -347,6 +639,9 @@ func (l *lexer) acceptRun(valid string) {
 type Token struct {
 	TokenType tokenType
 	Data      string
+
+	// Pos is the position in bytes of the token in the input.
+	Pos int
 }
 
 func (t tokenType) String() string {
@@ -357,18 +652,26 @@ func (t tokenType) String() string {
 		return "replacement"
 	case transformer:
 		return "transformer"
+	case transformerArg:
+		return "transformerArg"
 	case pluralNumeric:
 		return "pluralNumeric"
 	case pluralRange:
 		return "pluralRange"
 	case pluralOther:
 		return "pluralOther"
-	case pluralTranslationStart:
-		return "pluralTranslationStart"
-	case pluralTranslationEnd:
-		return "pluralTranslationEnd"
+	case pluralCategory:
+		return "pluralCategory"
+	case selectCase:
+		return "selectCase"
+	case caseStart:
+		return "caseStart"
+	case caseEnd:
+		return "caseEnd"
 	case pluralCount:
 		return "pluralCount"
+	case reference:
+		return "reference"
 	case errTok:
 		return "ERR"
 	default:
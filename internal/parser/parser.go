@@ -6,18 +6,54 @@ import (
 	"strings"
 )
 
-func Parse(input string) (*Message, error) {
+// Resolver returns the implementation of the transformer with the name and arguments.
+// It is called for every transformer in a message, so unknown transformers and invalid arguments are reported when the message is parsed.
+type Resolver func(name string, args []string) (any, error)
+
+type options struct {
+	resolve Resolver
+}
+
+// Option configures how messages are parsed.
+type Option func(o *options)
+
+// WithResolver sets the Resolver that is used to resolve the transformers of the message.
+// Without a Resolver any transformer name is accepted.
+func WithResolver(resolve Resolver) Option {
+	return func(o *options) {
+		o.resolve = resolve
+	}
+}
+
+func Parse(input string, opts ...Option) (*Message, error) {
+	o := newOptions(opts)
+
 	tokens, err := runLexer(input)
 	if err != nil {
 		return nil, err
 	}
 
-	it := newIterator(tokens)
+	ops, err := parseOps(newIterator(tokens), o)
+	if err != nil {
+		return nil, err
+	}
 
-	msg := &Message{
-		Ops: make([]any, 0),
+	return &Message{Ops: ops}, nil
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
 	}
 
+	return o
+}
+
+// parseOps parses literals and replacements until the end of the input or the end of a case body.
+func parseOps(it *iterator[Token], o *options) ([]any, error) {
+	ops := make([]any, 0)
+
 	for it.HasNext() {
 		token, ok := it.Next()
 		if !ok {
@@ -26,9 +62,17 @@ func Parse(input string) (*Message, error) {
 
 		switch token.TokenType {
 		case literal:
-			msg.Ops = append(msg.Ops, LiteralOp{Value: token.Data})
+			// A ':' that does not start a placeholder splits the literal in multiple tokens.
+			if len(ops) > 0 {
+				if last, ok := ops[len(ops)-1].(LiteralOp); ok {
+					ops[len(ops)-1] = LiteralOp{Value: last.Value + unescape(token.Data)}
+					continue
+				}
+			}
+
+			ops = append(ops, LiteralOp{Value: unescape(token.Data)})
 		case replacement:
-			transformers, err := parseTransformers(it)
+			transformers, err := parseTransformers(it, o)
 			if err != nil {
 				return nil, fmt.Errorf("unable to parse transformers: %w", err)
 			}
@@ -38,15 +82,20 @@ func Parse(input string) (*Message, error) {
 				Transformers: transformers,
 			}
 
-			msg.Ops = append(msg.Ops, replacementOp)
+			ops = append(ops, replacementOp)
+		case pluralCount:
+			ops = append(ops, PluralCountOp{})
+		case reference:
+			ops = append(ops, ReferenceOp{Key: token.Data})
+		case caseEnd:
+			return ops, nil
 		}
-
 	}
 
-	return msg, nil
+	return ops, nil
 }
 
-func parseTransformers(it *iterator[Token]) (transformers []any, err error) {
+func parseTransformers(it *iterator[Token], o *options) (transformers []any, err error) {
 	for it.HasNext() {
 		token, ok := it.Peek()
 		if !ok {
@@ -60,46 +109,162 @@ func parseTransformers(it *iterator[Token]) (transformers []any, err error) {
 		token, _ = it.Next()
 
 		switch token.Data {
-		case "capitalize":
-			transformers = append(transformers, CapitalizeTransformer{})
-		case "replace":
-			transformers = append(transformers, ReplaceTransformer{})
 		case "plural":
-			plural := PluralTransformer{
-				Cases: make([]PluralCase, 0),
+			cases, other, err := parsePluralCases(it, token.Data, o)
+			if err != nil {
+				return nil, err
 			}
 
-			for it.HasNext() {
-				// Parse all cases.
-				pcase, err := parsePluralCase(it)
-				if err != nil {
-					return nil, fmt.Errorf("unable to parse plural case: %w", err)
-				}
-
-				if pcase == nil {
-					break
-				}
+			transformers = append(transformers, PluralTransformer{Cases: cases, Other: other})
+		case "ordinal":
+			cases, other, err := parsePluralCases(it, token.Data, o)
+			if err != nil {
+				return nil, err
+			}
 
-				if pcase.Type == OpPluralCaseOther {
-					plural.Other = pcase.Ops
-					continue
-				}
+			transformers = append(transformers, OrdinalTransformer{Cases: cases, Other: other})
+		case "select":
+			sel, err := parseSelectCases(it, o)
+			if err != nil {
+				return nil, err
+			}
 
-				plural.Cases = append(plural.Cases, *pcase)
+			transformers = append(transformers, sel)
+		default:
+			args, err := parseArgs(it)
+			if err != nil {
+				return nil, err
 			}
 
-			if len(plural.Other) == 0 {
-				return nil, fmt.Errorf("missing 'other' case for plural transformer")
+			t, err := o.transformer(token.Data, args)
+			if err != nil {
+				return nil, &Error{Msg: err.Error(), Offset: token.Pos, Found: token.Data, Err: err}
 			}
 
-			transformers = append(transformers, plural)
+			transformers = append(transformers, t)
 		}
 	}
 
 	return transformers, nil
 }
 
-func parsePluralCase(it *iterator[Token]) (*PluralCase, error) {
+// transformer creates a Transformer and resolves its implementation.
+func (o *options) transformer(name string, args []string) (Transformer, error) {
+	t := Transformer{
+		Name: name,
+		Args: args,
+	}
+
+	if o.resolve == nil {
+		return t, nil
+	}
+
+	impl, err := o.resolve(name, args)
+	if err != nil {
+		return t, err
+	}
+
+	t.Impl = impl
+
+	return t, nil
+}
+
+// parseArgs collects the arguments of a transformer and unquotes quoted arguments.
+func parseArgs(it *iterator[Token]) ([]string, error) {
+	var args []string
+
+	for {
+		token, ok := it.Peek()
+		if !ok || token.TokenType != transformerArg {
+			return args, nil
+		}
+
+		it.Next()
+
+		arg := token.Data
+		if strings.HasPrefix(arg, `"`) {
+			var err error
+			arg, err = strconv.Unquote(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted argument %s: %w", token.Data, err)
+			}
+		}
+
+		args = append(args, arg)
+	}
+}
+
+// parseSelectCases parses the cases of a select transformer.
+// The body of every case is parsed as a message that can contain replacements.
+func parseSelectCases(it *iterator[Token], o *options) (SelectTransformer, error) {
+	sel := SelectTransformer{
+		Cases: make([]SelectCase, 0),
+	}
+
+	for it.HasNext() {
+		token, _ := it.Peek()
+		if token.TokenType != selectCase {
+			break
+		}
+
+		it.Next()
+
+		start, ok := it.Next()
+		if !ok || start.TokenType != caseStart {
+			return sel, fmt.Errorf("expected case start token for select case %q", token.Data)
+		}
+
+		ops, err := parseOps(it, o)
+		if err != nil {
+			return sel, fmt.Errorf("unable to parse select case %q: %w", token.Data, err)
+		}
+
+		if token.Data == "other" {
+			sel.Other = ops
+			continue
+		}
+
+		sel.Cases = append(sel.Cases, SelectCase{Value: token.Data, Ops: ops})
+	}
+
+	if sel.Other == nil {
+		return sel, fmt.Errorf("missing 'other' case for select transformer")
+	}
+
+	return sel, nil
+}
+
+// parsePluralCases parses the cases of a plural or ordinal transformer.
+func parsePluralCases(it *iterator[Token], name string, o *options) (cases []PluralCase, other []any, err error) {
+	cases = make([]PluralCase, 0)
+
+	for it.HasNext() {
+		// Parse all cases.
+		pcase, err := parsePluralCase(it, o)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse %s case: %w", name, err)
+		}
+
+		if pcase == nil {
+			break
+		}
+
+		if pcase.Type == OpPluralCaseOther {
+			other = pcase.Ops
+			continue
+		}
+
+		cases = append(cases, *pcase)
+	}
+
+	if len(other) == 0 {
+		return nil, nil, fmt.Errorf("missing 'other' case for %s transformer", name)
+	}
+
+	return cases, other, nil
+}
+
+func parsePluralCase(it *iterator[Token], o *options) (*PluralCase, error) {
 	pcase := &PluralCase{
 		Type: OpPluralCaseTypeExact,
 		Ops:  make([]any, 0),
@@ -111,7 +276,7 @@ func parsePluralCase(it *iterator[Token]) (*PluralCase, error) {
 		return nil, nil
 	}
 
-	if peek.TokenType != pluralNumeric && peek.TokenType != pluralOther {
+	if peek.TokenType != pluralNumeric && peek.TokenType != pluralOther && peek.TokenType != pluralCategory {
 		return nil, nil
 	}
 
@@ -122,11 +287,11 @@ func parsePluralCase(it *iterator[Token]) (*PluralCase, error) {
 			return nil, nil
 		}
 
-		if token.TokenType == pluralTranslationStart {
+		if token.TokenType == caseStart {
 			break
 		}
 
-		// The first token could be a numeric or the other keyword.
+		// The first token could be a numeric, a plural category or the other keyword.
 		switch token.TokenType {
 		case pluralNumeric:
 			// If the type of pcase is range then we have already got the A value, if not we need to set it.
@@ -147,6 +312,9 @@ func parsePluralCase(it *iterator[Token]) (*PluralCase, error) {
 			pcase.Type = OpPluralCaseTypeRange
 		case pluralOther:
 			pcase.Type = OpPluralCaseOther
+		case pluralCategory:
+			pcase.Type = OpPluralCaseCategory
+			pcase.Category = token.Data
 		default:
 			return nil, fmt.Errorf("unexpected end of plural case with type %s", token.TokenType) // Some unknown token, we should stop parsing the case.
 		}
@@ -161,29 +329,18 @@ func parsePluralCase(it *iterator[Token]) (*PluralCase, error) {
 		return nil, nil
 	}
 
-	if token.TokenType != pluralTranslationStart {
+	if token.TokenType != caseStart {
 		return nil, fmt.Errorf("expected translation start token, got %s", token.TokenType)
 	}
 
-	// Collect all operations until we reach the end of the translation.
-	for it.HasNext() {
-		token, ok := it.Next()
-		if !ok {
-			return nil, fmt.Errorf("unexpected end of plural case")
-		}
-
-		if token.TokenType == pluralTranslationEnd {
-			break
-		}
-
-		switch token.TokenType {
-		case literal:
-			pcase.Ops = append(pcase.Ops, LiteralOp{Value: token.Data})
-		case pluralCount:
-			pcase.Ops = append(pcase.Ops, PluralCountOp{})
-		}
+	// The translation is a message that ends at the end of the case.
+	ops, err := parseOps(it, o)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse plural translation: %w", err)
 	}
 
+	pcase.Ops = ops
+
 	return pcase, nil
 }
 
@@ -193,97 +350,349 @@ type Message struct {
 
 func (m Message) Raw() string {
 	var b strings.Builder
-	for _, op := range m.Ops {
+	writeOps(&b, m.Ops, false, false)
+
+	return b.String()
+}
+
+// References returns the keys of the messages that are referenced by the message, including the references in cases.
+func (m Message) References() []string {
+	return appendReferences(nil, m.Ops)
+}
+
+func appendReferences(refs []string, ops []any) []string {
+	for _, op := range ops {
+		switch v := op.(type) {
+		case ReferenceOp:
+			refs = append(refs, v.Key)
+		case ReplacementOp:
+			for _, transformer := range v.Transformers {
+				switch t := transformer.(type) {
+				case PluralTransformer:
+					for _, c := range t.Cases {
+						refs = appendReferences(refs, c.Ops)
+					}
+					refs = appendReferences(refs, t.Other)
+				case OrdinalTransformer:
+					for _, c := range t.Cases {
+						refs = appendReferences(refs, c.Ops)
+					}
+					refs = appendReferences(refs, t.Other)
+				case SelectTransformer:
+					for _, c := range t.Cases {
+						refs = appendReferences(refs, c.Ops)
+					}
+					refs = appendReferences(refs, t.Other)
+				}
+			}
+		}
+	}
+
+	return refs
+}
+
+// Transformers returns the transformers of the message, including the transformers in the cases of a plural, ordinal
+// or select. The plural, ordinal and select transformers themselves are not returned.
+func (m Message) Transformers() []Transformer {
+	return appendTransformers(nil, m.Ops)
+}
+
+func appendTransformers(transformers []Transformer, ops []any) []Transformer {
+	for _, op := range ops {
+		v, ok := op.(ReplacementOp)
+		if !ok {
+			continue
+		}
+
+		for _, transformer := range v.Transformers {
+			switch t := transformer.(type) {
+			case Transformer:
+				transformers = append(transformers, t)
+			case PluralTransformer:
+				for _, c := range t.Cases {
+					transformers = appendTransformers(transformers, c.Ops)
+				}
+				transformers = appendTransformers(transformers, t.Other)
+			case OrdinalTransformer:
+				for _, c := range t.Cases {
+					transformers = appendTransformers(transformers, c.Ops)
+				}
+				transformers = appendTransformers(transformers, t.Other)
+			case SelectTransformer:
+				for _, c := range t.Cases {
+					transformers = appendTransformers(transformers, c.Ops)
+				}
+				transformers = appendTransformers(transformers, t.Other)
+			}
+		}
+	}
+
+	return transformers
+}
+
+// writeOps writes the raw ops. The inCase is true in the body of a case, where a '}' ends the body,
+// and inPlural is true in the body of a plural case, where a '#' is the count.
+func writeOps(b *strings.Builder, ops []any, inCase, inPlural bool) {
+	ops = mergeLiterals(ops)
+
+	for i, op := range ops {
 		switch v := op.(type) {
 		case LiteralOp:
-			b.WriteString(v.Value)
+			// The text after the literal is an op or the end of the case body.
+			followed := i+1 < len(ops) || inCase
+			writeLiteral(b, v.Value, followed, inCase, inPlural)
+		case PluralCountOp:
+			b.WriteRune('#')
+		case ReferenceOp:
+			b.WriteString("@{" + v.Key + "}")
 		case ReplacementOp:
-			b.WriteString(":" + v.Key)
+			// Delimit the placeholder if the text that follows would otherwise be part of it.
+			delimited := i+1 < len(ops) && needsDelimiter(ops[i+1])
+			if delimited {
+				b.WriteString(":{" + v.Key)
+			} else {
+				b.WriteString(":" + v.Key)
+			}
 
 			for _, transformer := range v.Transformers {
 				b.WriteRune('|')
 
 				switch t := transformer.(type) {
-				case CapitalizeTransformer:
-					b.WriteString("capitalize")
-				case ReplaceTransformer:
-					b.WriteString("replace")
+				case Transformer:
+					b.WriteString(t.Name)
+					writeArgs(b, t.Args)
 				case PluralTransformer:
 					b.WriteString("plural")
-
-					b.WriteRune('(')
+					writePluralCases(b, t.Cases, t.Other)
+				case OrdinalTransformer:
+					b.WriteString("ordinal")
+					writePluralCases(b, t.Cases, t.Other)
+				case SelectTransformer:
+					b.WriteString("select(")
 					for _, c := range t.Cases {
-						b.WriteRune('=')
-
-						if c.Type == OpPluralCaseTypeRange {
-							b.WriteString(strconv.Itoa(c.A))
-							b.WriteRune('-')
-							b.WriteString(strconv.Itoa(c.B))
-						} else {
-							b.WriteString(strconv.Itoa(c.A))
-						}
-
-						b.WriteRune(' ')
-
-						b.WriteRune('{')
-						for _, c := range c.Ops {
-							switch c := c.(type) {
-							case LiteralOp:
-								b.WriteString(c.Value)
-							case PluralCountOp:
-								b.WriteRune('#')
-							}
-						}
-						b.WriteRune('}')
-					}
-
-					if len(t.Other) > 0 {
-						b.WriteString(" other {")
-						for _, c := range t.Other {
-							switch c := c.(type) {
-							case LiteralOp:
-								b.WriteString(c.Value)
-							case PluralCountOp:
-								b.WriteRune('#')
-							}
-						}
-						b.WriteRune('}')
+						b.WriteString(c.Value)
+						b.WriteString(" {")
+						writeOps(b, c.Ops, true, inPlural)
+						b.WriteString("} ")
 					}
 
-					b.WriteRune(')')
+					b.WriteString("other {")
+					writeOps(b, t.Other, true, inPlural)
+					b.WriteString("})")
 				}
 			}
+
+			if delimited {
+				b.WriteRune('}')
+			}
 		}
 	}
+}
 
-	return b.String()
+// mergeLiterals merges consecutive literals, so the escaping of a literal can take the text that follows into account.
+func mergeLiterals(ops []any) []any {
+	merged := make([]any, 0, len(ops))
+	for _, op := range ops {
+		if literal, ok := op.(LiteralOp); ok && len(merged) > 0 {
+			if last, ok := merged[len(merged)-1].(LiteralOp); ok {
+				merged[len(merged)-1] = LiteralOp{Value: last.Value + literal.Value}
+				continue
+			}
+		}
+
+		merged = append(merged, op)
+	}
+
+	return merged
+}
+
+// Placeholder returns the placeholder of the key as it is written in a message, like :name.
+// It is delimited, like :{name}, if the next op would otherwise be read as part of the placeholder.
+func Placeholder(key string, next any) string {
+	if needsDelimiter(next) {
+		return ":{" + key + "}"
+	}
+
+	return ":" + key
+}
+
+// needsDelimiter returns true if the op that follows a placeholder starts with text that would be lexed as part of the placeholder.
+func needsDelimiter(next any) bool {
+	literal, ok := next.(LiteralOp)
+	if !ok || literal.Value == "" {
+		return false
+	}
+
+	switch r := rune(literal.Value[0]); {
+	case strings.ContainsRune(identifierStart+digits+"|(", r):
+		return true
+	case r == '.' && len(literal.Value) > 1:
+		return strings.ContainsRune(identifierStart, rune(literal.Value[1]))
+	}
+
+	return false
+}
+
+// writeLiteral writes the literal with a backslash before the characters that would otherwise be lexed as syntax.
+func writeLiteral(b *strings.Builder, s string, followed, inCase, inPlural bool) {
+	for i, r := range s {
+		next := rune(-1)
+		if i+1 < len(s) {
+			next = rune(s[i+1])
+		}
+
+		var escape bool
+		switch r {
+		case '\\':
+			// The text that follows a literal always starts with an escapable character.
+			escape = strings.ContainsRune(escapable, next) || (next == -1 && followed)
+		case ':':
+			escape = strings.ContainsRune(identifierStart, next) || (next == '{' && i+2 < len(s) && strings.ContainsRune(identifierStart, rune(s[i+2])))
+		case '@':
+			escape = next == '{'
+		case '#':
+			escape = inPlural
+		case '}':
+			escape = inCase
+		}
+
+		if escape {
+			b.WriteRune('\\')
+		}
+
+		b.WriteRune(r)
+	}
+}
+
+// writeArgs writes the arguments of a transformer in parentheses.
+// Arguments that can not be written as is are quoted.
+func writeArgs(b *strings.Builder, args []string) {
+	if len(args) == 0 {
+		return
+	}
+
+	b.WriteRune('(')
+	for i, arg := range args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		if arg == "" || strings.ContainsAny(arg, spaces+","+argDelimiters) {
+			arg = strconv.Quote(arg)
+		}
+
+		b.WriteString(arg)
+	}
+	b.WriteRune(')')
+}
+
+// writePluralCases writes the raw cases of a plural or ordinal transformer including the parentheses.
+func writePluralCases(b *strings.Builder, cases []PluralCase, other []any) {
+	b.WriteRune('(')
+	for i, c := range cases {
+		if i > 0 {
+			b.WriteRune(' ')
+		}
+
+		switch c.Type {
+		case OpPluralCaseTypeRange:
+			b.WriteRune('=')
+			b.WriteString(strconv.Itoa(c.A))
+			b.WriteRune('-')
+			b.WriteString(strconv.Itoa(c.B))
+		case OpPluralCaseCategory:
+			b.WriteString(c.Category)
+		default:
+			b.WriteRune('=')
+			b.WriteString(strconv.Itoa(c.A))
+		}
+
+		b.WriteString(" {")
+		writeOps(b, c.Ops, true, true)
+		b.WriteRune('}')
+	}
+
+	if len(other) > 0 {
+		if len(cases) > 0 {
+			b.WriteRune(' ')
+		}
+
+		b.WriteString("other {")
+		writeOps(b, other, true, true)
+		b.WriteRune('}')
+	}
+
+	b.WriteRune(')')
 }
 
 type LiteralOp struct {
 	Value string
 }
 
+// ReferenceOp is a reference to another message of the same language, like `@{settings.title}`.
+type ReferenceOp struct {
+	Key string
+}
+
+// ReplacementOp is a placeholder like `:name` or `:user.name`. The Key holds the full name including the dots.
 type ReplacementOp struct {
 	Key          string
 	Transformers []any
 }
 
-type CapitalizeTransformer struct{}
+// Path returns the parts of the key, like ["user", "name"] for `:user.name`.
+func (r ReplacementOp) Path() []string {
+	return strings.Split(r.Key, ".")
+}
+
+// Transformer is a transformer with optional arguments, like `capitalize` or `number(percent)`.
+// Only plural, ordinal and select have their own syntax.
+type Transformer struct {
+	Name string
+	Args []string
 
-type ReplaceTransformer struct{}
+	// Impl is the implementation that is returned by the Resolver.
+	// It is nil if the message is parsed without a Resolver.
+	Impl any
+}
 
 type PluralTransformer struct {
 	Cases []PluralCase
 	Other []any
 }
 
+// OrdinalTransformer selects a case based on the CLDR ordinal category of the count (1st, 2nd, 3rd).
+// It supports the same cases as the PluralTransformer.
+type OrdinalTransformer struct {
+	Cases []PluralCase
+	Other []any
+}
+
+// SelectTransformer selects a case by matching the replacement value against the case values.
+// If no case matches the Other case is used.
+type SelectTransformer struct {
+	Cases []SelectCase
+	Other []any
+}
+
+type SelectCase struct {
+	Value string
+
+	// Ops is a list of operations that should be applied if the value matches.
+	// This can be a list of LiteralOp, ReplacementOp and, inside a plural case, PluralCountOp.
+	Ops []any
+}
+
 type PluralCase struct {
 	Type OpPluralCaseType
 	A    int
 	B    int
 
+	// Category is the CLDR plural category (zero, one, two, few or many) for OpPluralCaseCategory cases.
+	Category string
+
 	// Ops is a list of operations that should be applied if the case is true.
-	// This can be a list of LiteralOp and PluralCountOp.
+	// This can be a list of LiteralOp, ReplacementOp and PluralCountOp.
 	Ops []any
 }
 
@@ -306,4 +715,5 @@ const (
 	OpPluralCaseTypeRange OpPluralCaseType = iota
 	OpPluralCaseTypeExact
 	OpPluralCaseOther
+	OpPluralCaseCategory
 )
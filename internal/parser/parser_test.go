@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -31,3 +32,301 @@ func TestParse(t *testing.T) {
 	require.Len(t, plural.Cases, 2)
 	require.Len(t, plural.Other, 4)
 }
+
+func TestParsePluralCategories(t *testing.T) {
+	source := ":count|plural(=0 {none} one {# item} few {# items} other {# things})"
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+	require.Len(t, message.Ops, 1)
+
+	replacement, ok := message.Ops[0].(ReplacementOp)
+	require.True(t, ok)
+
+	plural, ok := replacement.Transformers[0].(PluralTransformer)
+	require.True(t, ok)
+	require.Len(t, plural.Cases, 3)
+	require.Equal(t, OpPluralCaseTypeExact, plural.Cases[0].Type)
+	require.Equal(t, OpPluralCaseCategory, plural.Cases[1].Type)
+	require.Equal(t, "one", plural.Cases[1].Category)
+	require.Equal(t, "few", plural.Cases[2].Category)
+	require.Len(t, plural.Other, 2)
+}
+
+func TestParseOrdinal(t *testing.T) {
+	source := "Finished :place|ordinal(=1 {first} one {#st} two {#nd} few {#rd} other {#th})"
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+	require.Len(t, message.Ops, 2)
+
+	replacement, ok := message.Ops[1].(ReplacementOp)
+	require.True(t, ok)
+
+	ordinal, ok := replacement.Transformers[0].(OrdinalTransformer)
+	require.True(t, ok)
+	require.Len(t, ordinal.Cases, 4)
+	require.Len(t, ordinal.Other, 2)
+
+	require.Equal(t, source, message.Raw())
+
+	_, err = Parse(":place|ordinal(one {#st})")
+	require.Error(t, err)
+}
+
+func TestParseSelect(t *testing.T) {
+	source := ":gender|select(male {He invited :guest|capitalize} female {She invited :count|plural(one {# guest} other {# guests})} other {They invited :guest})!"
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+	require.Len(t, message.Ops, 2)
+
+	replacement, ok := message.Ops[0].(ReplacementOp)
+	require.True(t, ok)
+	require.Equal(t, "gender", replacement.Key)
+
+	sel, ok := replacement.Transformers[0].(SelectTransformer)
+	require.True(t, ok)
+	require.Len(t, sel.Cases, 2)
+	require.Equal(t, "male", sel.Cases[0].Value)
+	require.Equal(t, []any{LiteralOp{Value: "He invited "}, ReplacementOp{Key: "guest", Transformers: []any{Transformer{Name: "capitalize"}}}}, sel.Cases[0].Ops)
+	require.Equal(t, "female", sel.Cases[1].Value)
+	require.Len(t, sel.Cases[1].Ops, 2)
+	require.Len(t, sel.Other, 2)
+
+	require.Equal(t, LiteralOp{Value: "!"}, message.Ops[1])
+	require.Equal(t, source, message.Raw())
+
+	_, err = Parse(":gender|select(male {He})")
+	require.Error(t, err)
+
+	_, err = Parse(":gender|select(male {He} other {They)")
+	require.Error(t, err)
+}
+
+func TestParsePluralNested(t *testing.T) {
+	source := ":count|plural(=1 {:user|capitalize has one item} other {:user has # items, :gender|select(female {she has #} other {they have #})})"
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+	require.Len(t, message.Ops, 1)
+
+	plural, ok := message.Ops[0].(ReplacementOp).Transformers[0].(PluralTransformer)
+	require.True(t, ok)
+	require.Equal(t, []any{
+		ReplacementOp{Key: "user", Transformers: []any{Transformer{Name: "capitalize"}}},
+		LiteralOp{Value: " has one item"},
+	}, plural.Cases[0].Ops)
+
+	require.Len(t, plural.Other, 5)
+	require.Equal(t, ReplacementOp{Key: "user"}, plural.Other[0])
+	require.Equal(t, PluralCountOp{}, plural.Other[2])
+
+	sel, ok := plural.Other[4].(ReplacementOp).Transformers[0].(SelectTransformer)
+	require.True(t, ok)
+	require.Equal(t, []any{LiteralOp{Value: "she has "}, PluralCountOp{}}, sel.Cases[0].Ops)
+
+	require.Equal(t, source, message.Raw())
+
+	// Outside of a plural case the '#' is a literal.
+	message, err = Parse(":gender|select(female {#1} other {#2})")
+	require.NoError(t, err)
+	require.Equal(t, []any{LiteralOp{Value: "#1"}}, message.Ops[0].(ReplacementOp).Transformers[0].(SelectTransformer).Cases[0].Ops)
+
+	_, err = Parse(":count|plural(=1 {:user|unknown(} other {#})")
+	require.Error(t, err)
+}
+
+func TestParseTransformerArgs(t *testing.T) {
+	source := `Total :n|number(percent, decimals=1)|capitalize on :at|datetime("EEEE, d MMMM \"y\"") and :m|number.`
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+	require.Len(t, message.Ops, 7)
+
+	replacement, ok := message.Ops[1].(ReplacementOp)
+	require.True(t, ok)
+	require.Equal(t, []any{Transformer{Name: "number", Args: []string{"percent", "decimals=1"}}, Transformer{Name: "capitalize"}}, replacement.Transformers)
+
+	replacement, ok = message.Ops[3].(ReplacementOp)
+	require.True(t, ok)
+	require.Equal(t, []any{Transformer{Name: "datetime", Args: []string{`EEEE, d MMMM "y"`}}}, replacement.Transformers)
+
+	replacement, ok = message.Ops[5].(ReplacementOp)
+	require.True(t, ok)
+	require.Equal(t, []any{Transformer{Name: "number"}}, replacement.Transformers)
+
+	require.Equal(t, source, message.Raw())
+
+	for _, source := range []string{":n|number(percent", ":n|number(a{b)", `:at|date("unterminated)`, ":n|Number"} {
+		_, err = Parse(source)
+		require.Error(t, err, source)
+	}
+}
+
+func TestParseResolver(t *testing.T) {
+	resolve := func(name string, args []string) (any, error) {
+		if name != "truncate" {
+			return nil, fmt.Errorf("unknown transformer %q", name)
+		}
+
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected a length")
+		}
+
+		return args[0], nil
+	}
+
+	message, err := Parse(":gender|select(male {:name|truncate(10)} other {:name})", WithResolver(resolve))
+	require.NoError(t, err)
+
+	sel := message.Ops[0].(ReplacementOp).Transformers[0].(SelectTransformer)
+	require.Equal(t, []any{ReplacementOp{Key: "name", Transformers: []any{Transformer{Name: "truncate", Args: []string{"10"}, Impl: "10"}}}}, sel.Cases[0].Ops)
+
+	_, err = Parse(":name|upper", WithResolver(resolve))
+	require.ErrorContains(t, err, `unknown transformer "upper"`)
+
+	_, err = Parse(":name|truncate", WithResolver(resolve))
+	require.ErrorContains(t, err, "expected a length")
+
+	// Without a resolver every transformer is accepted.
+	_, err = Parse(":name|upper")
+	require.NoError(t, err)
+}
+
+func TestMessageTransformers(t *testing.T) {
+	message, err := Parse(":at|date(short) :count|plural(one {:user|upper} other {:gender|select(male {:at|time} other {:items|list})})")
+	require.NoError(t, err)
+
+	names := make([]string, 0)
+	for _, transformer := range message.Transformers() {
+		names = append(names, transformer.Name)
+	}
+
+	require.Equal(t, []string{"date", "upper", "time", "list"}, names)
+}
+
+func TestParseReference(t *testing.T) {
+	source := "Go to @{settings.title} or :count|plural(one {@{item_1}} other {@{items-many}}), mail me@example.com"
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+
+	require.Equal(t, LiteralOp{Value: "Go to "}, message.Ops[0])
+	require.Equal(t, ReferenceOp{Key: "settings.title"}, message.Ops[1])
+	require.Equal(t, []string{"settings.title", "item_1", "items-many"}, message.References())
+	require.Equal(t, source, message.Raw())
+
+	for _, source := range []string{"@{}", "@{settings.title", "@{settings title}"} {
+		_, err = Parse(source)
+		require.Error(t, err, source)
+	}
+}
+
+func TestParsePlaceholderNames(t *testing.T) {
+	source := "Hi :user_name, :firstName has :item2 and :user.address.city|capitalize. At 10:30 :_private:count."
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+
+	require.Equal(t, []any{
+		LiteralOp{Value: "Hi "},
+		ReplacementOp{Key: "user_name"},
+		LiteralOp{Value: ", "},
+		ReplacementOp{Key: "firstName"},
+		LiteralOp{Value: " has "},
+		ReplacementOp{Key: "item2"},
+		LiteralOp{Value: " and "},
+		ReplacementOp{Key: "user.address.city", Transformers: []any{Transformer{Name: "capitalize"}}},
+		LiteralOp{Value: ". At 10:30 "},
+		ReplacementOp{Key: "_private"},
+		ReplacementOp{Key: "count"},
+		LiteralOp{Value: "."},
+	}, message.Ops)
+	require.Equal(t, []string{"user", "address", "city"}, message.Ops[7].(ReplacementOp).Path())
+	require.Equal(t, source, message.Raw())
+}
+
+func TestParseDelimitedPlaceholder(t *testing.T) {
+	source := "Ratio :total:count, the :{item}th, :{name|capitalize}s and :{count|plural(one {:{unit}s} other {# :unit})}!"
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+
+	require.Equal(t, ReplacementOp{Key: "total"}, message.Ops[1])
+	require.Equal(t, ReplacementOp{Key: "count"}, message.Ops[2])
+	require.Equal(t, LiteralOp{Value: ", the "}, message.Ops[3])
+	require.Equal(t, ReplacementOp{Key: "item"}, message.Ops[4])
+	require.Equal(t, LiteralOp{Value: "th, "}, message.Ops[5])
+	require.Equal(t, ReplacementOp{Key: "name", Transformers: []any{Transformer{Name: "capitalize"}}}, message.Ops[6])
+	require.Equal(t, LiteralOp{Value: "s and "}, message.Ops[7])
+
+	plural := message.Ops[8].(ReplacementOp).Transformers[0].(PluralTransformer)
+	require.Equal(t, []any{ReplacementOp{Key: "unit"}, LiteralOp{Value: "s"}}, plural.Cases[0].Ops)
+	require.Equal(t, LiteralOp{Value: "!"}, message.Ops[9])
+
+	// Only placeholders that need it are delimited.
+	require.Equal(t, "Ratio :total:count, the :{item}th, :{name|capitalize}s and :count|plural(one {:{unit}s} other {# :unit})!", message.Raw())
+
+	for _, source := range []string{":{name", ":{name x}", ":{name|capitalize"} {
+		_, err = Parse(source)
+		require.Error(t, err, source)
+	}
+
+	message, err = Parse("Time :{0} and :{ name}")
+	require.NoError(t, err)
+	require.Equal(t, "Time :{0} and :{ name}", message.Raw())
+}
+
+func TestParseEscape(t *testing.T) {
+	source := `Use \:name, \@{key} and C:\path :count|plural(one {\# \} \| \{ \\} other {# items})`
+
+	message, err := Parse(source)
+	require.NoError(t, err)
+
+	require.Equal(t, LiteralOp{Value: `Use :name, @{key} and C:\path `}, message.Ops[0])
+
+	plural := message.Ops[1].(ReplacementOp).Transformers[0].(PluralTransformer)
+	require.Equal(t, []any{LiteralOp{Value: `# } | { \`}}, plural.Cases[0].Ops)
+	require.Equal(t, `Use \:name, \@{key} and C:\path :count|plural(one {\# \} | { \\} other {# items})`, message.Raw())
+
+	// Raw escapes literals that are created without the lexer.
+	message = &Message{Ops: []any{
+		LiteralOp{Value: "a:b"},
+		ReplacementOp{Key: "name"},
+		LiteralOp{Value: "|upper. #"},
+		LiteralOp{Value: `\`},
+		ReplacementOp{Key: "last"},
+	}}
+	require.Equal(t, `a\:b:{name}|upper. #\\:last`, message.Raw())
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected *Error
+	}{
+		{source: ":name|plural(one {x}", expected: &Error{Msg: "unexpected EOF", Offset: 20}},
+		{source: ":name|1", expected: &Error{Msg: "expected lowercase transformer name", Offset: 6, Expected: "transformer name", Found: "1"}},
+		{source: ":name|number(integer", expected: &Error{Msg: "unexpected EOF, expected ')'", Offset: 20, Expected: "')'"}},
+		{source: ":n|plural(foo {x} other {y})", expected: &Error{Msg: "expected plural selector", Offset: 10, Expected: "plural selector", Found: "foo"}},
+		{source: ":n|plural(one {x} fwe {y} other {z})", expected: &Error{Msg: "expected plural selector", Offset: 18, Expected: "plural selector", Found: "fwe"}},
+		{source: ":n|ordinal(one {x}, other {y})", expected: &Error{Msg: "expected plural selector", Offset: 18, Expected: "plural selector", Found: ","}},
+		{source: "@{key", expected: &Error{Msg: "expected '}' after message key in reference", Offset: 5, Expected: "'}'"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			_, err := Parse(tt.source)
+
+			var parseErr *Error
+			require.ErrorAs(t, err, &parseErr)
+			require.Equal(t, tt.expected, parseErr)
+		})
+	}
+
+	_, err := ParseICU("{name, number, ::currency/XX}")
+	var parseErr *Error
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, `invalid currency "XX" at position 28 (found "}")`, parseErr.Error())
+}
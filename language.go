@@ -4,13 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"golang.org/x/text/language"
 )
 
 var (
 	languageKey = ctxKey("lingua")
-	langRe      = regexp.MustCompile(`(?i)([a-z]{2,8})([-_][a-z]{4})?([-_][a-z]{2}|\d{3})?`)
+	langRe      = regexp.MustCompile(`(?i)([a-z]{2,8})(-[a-z]{4}\b)?(-(?:[a-z]{2}|\d{3})\b)?(-(?:[a-z0-9]{5,8}|\d[a-z0-9]{3})\b)*`)
 )
 
 // WithLanguage parses the given raw language and adds it to the ctx.
@@ -52,8 +53,10 @@ func MustParseLanguage(lang string) LanguageID {
 }
 
 // ParseLanguage parses the language string into a LanguageID.
+// The string is a BCP 47 language tag like en, en-US, zh-Hant-TW or sl-rozaj, underscores are accepted as separator.
 func ParseLanguage(lang string) (LanguageID, error) {
-	match := langRe.FindString(lang)
+	// An underscore is a word character, which would stop the subtags from matching at a word boundary.
+	match := langRe.FindString(strings.ReplaceAll(lang, "_", "-"))
 	if match == "" {
 		return LanguageID{}, fmt.Errorf("invalid language: %s", lang)
 	}
@@ -64,47 +67,117 @@ func ParseLanguage(lang string) (LanguageID, error) {
 		return LanguageID{}, fmt.Errorf("error parsing %s: %w", lang, err)
 	}
 
+	if _, baseconf := tag.Base(); baseconf != language.Exact {
+		return LanguageID{}, fmt.Errorf("error parsing %s: could not parse base language", lang)
+	}
+
+	return LanguageFromTag(tag), nil
+}
+
+// LanguageFromTag returns the LanguageID of the tag. Only the parts that are set in the tag are used,
+// so the script of zh-TW is empty even though Traditional Chinese is the likely script.
+func LanguageFromTag(tag language.Tag) LanguageID {
 	var id LanguageID
 
 	base, baseconf := tag.Base()
-	if baseconf != language.Exact {
-		return LanguageID{}, fmt.Errorf("error parsing %s: could not parse base language", lang)
+	if baseconf == language.Exact {
+		id.Language = base.String()
 	}
 
-	id.Language = base.String()
+	script, scriptconf := tag.Script()
+	if scriptconf == language.Exact {
+		id.Script = script.String()
+	}
 
 	region, regionconf := tag.Region()
 	if regionconf == language.Exact {
 		id.Region = region.String()
 	}
 
-	return id, nil
+	variants := make([]string, 0, len(tag.Variants()))
+	for _, variant := range tag.Variants() {
+		variants = append(variants, variant.String())
+	}
+	id.Variants = strings.Join(variants, "-")
+
+	return id
 }
 
-// LanguageID holds the language and an optional region.
+// LanguageID holds the language and an optional script, region and variants of a BCP 47 language tag.
+// The fields are strings so a LanguageID can be compared and used as map key.
 type LanguageID struct {
 	Language string
+	Script   string
 	Region   string
+
+	// Variants holds the variants separated by a dash, like "rozaj-biske".
+	Variants string
 }
 
 func (l LanguageID) String() string {
-	if l.Region != "" {
-		return l.Language + "-" + l.Region
+	parts := make([]string, 0, 4)
+	for _, part := range []string{l.Language, l.Script, l.Region, l.Variants} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// Tag returns the language.Tag for the LanguageID.
+func (l LanguageID) Tag() language.Tag {
+	tag, err := language.Parse(l.String())
+	if err != nil {
+		return language.Und
 	}
 
-	return l.Language
+	return tag
 }
 
 func (l LanguageID) Empty() bool {
-	return l.Language == "" && l.Region == ""
+	return l == LanguageID{}
+}
+
+// Parent returns the LanguageID without its most specific part, like zh-Hant for zh-Hant-TW and zh for zh-Hant.
+// The parent of a language without script, region and variants is empty.
+func (l LanguageID) Parent() LanguageID {
+	switch {
+	case l.Variants != "":
+		l.Variants = ""
+	case l.Region != "":
+		l.Region = ""
+	case l.Script != "":
+		l.Script = ""
+	default:
+		return LanguageID{}
+	}
+
+	return l
+}
+
+// likelyScript returns the script of the language, or the likely script if the language has no script,
+// like Hant for zh-TW. It is empty if the script can not be determined.
+func (l LanguageID) likelyScript() string {
+	if l.Script != "" {
+		return l.Script
+	}
+
+	if script, conf := l.Tag().Script(); conf != language.No {
+		return script.String()
+	}
+
+	return ""
 }
 
+// Match returns true if both IDs have the same language and script, an empty script matches any script.
+// The strongMatch is true if all parts are the same.
 func (l LanguageID) Match(cmp LanguageID) (match bool, strongMatch bool) {
-	if l.Language == cmp.Language && l.Region == cmp.Region {
+	if l == cmp {
 		return true, true
 	}
 
-	if l.Language == cmp.Language {
+	if l.Language == cmp.Language && (l.Script == cmp.Script || l.Script == "" || cmp.Script == "") {
 		return true, false
 	}
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
 func TestLanguageCtx(t *testing.T) {
@@ -97,3 +98,55 @@ func TestToCtx(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLanguage(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected LanguageID
+	}{
+		{input: "en", expected: LanguageID{Language: "en"}},
+		{input: "en_GB", expected: LanguageID{Language: "en", Region: "GB"}},
+		{input: "zh-Hant", expected: LanguageID{Language: "zh", Script: "Hant"}},
+		{input: "zh-hant-tw", expected: LanguageID{Language: "zh", Script: "Hant", Region: "TW"}},
+		{input: "zh_Hant_TW", expected: LanguageID{Language: "zh", Script: "Hant", Region: "TW"}},
+		{input: "sr-Latn-RS", expected: LanguageID{Language: "sr", Script: "Latn", Region: "RS"}},
+		{input: "es-419", expected: LanguageID{Language: "es", Region: "419"}},
+		{input: "de-CH-1996", expected: LanguageID{Language: "de", Region: "CH", Variants: "1996"}},
+		{input: "sl-rozaj-biske", expected: LanguageID{Language: "sl", Variants: "rozaj-biske"}},
+		{input: "zh-Hant-TW,zh;q=0.5", expected: LanguageID{Language: "zh", Script: "Hant", Region: "TW"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			lang, err := ParseLanguage(c.input)
+			require.NoError(t, err)
+			require.Equal(t, c.expected, lang)
+
+			// The LanguageID survives a round trip through a language.Tag.
+			require.Equal(t, lang, LanguageFromTag(lang.Tag()))
+			require.Equal(t, lang.String(), lang.Tag().String())
+		})
+	}
+
+	// The likely script is not part of the LanguageID.
+	require.Equal(t, LanguageID{Language: "zh", Region: "TW"}, LanguageFromTag(language.MustParse("zh-TW")))
+}
+
+func TestLanguageMatch(t *testing.T) {
+	hant := MustParseLanguage("zh-Hant-TW")
+
+	match, strong := hant.Match(MustParseLanguage("zh-Hant-TW"))
+	require.True(t, match)
+	require.True(t, strong)
+
+	match, strong = hant.Match(MustParseLanguage("zh-HK"))
+	require.True(t, match)
+	require.False(t, strong)
+
+	match, _ = hant.Match(MustParseLanguage("zh-Hans-TW"))
+	require.False(t, match)
+
+	require.Equal(t, MustParseLanguage("zh-Hant"), hant.Parent())
+	require.Equal(t, MustParseLanguage("zh"), hant.Parent().Parent())
+	require.True(t, hant.Parent().Parent().Parent().Empty())
+}
@@ -0,0 +1,113 @@
+// Package linguagrpc provides grpc interceptors that pass the language of a call through the metadata.
+package linguagrpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/SLASH2NL/lingua"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// DefaultMetadataKey is the metadata key of the language if no key is set with WithMetadataKey.
+const DefaultMetadataKey = "lingua-language"
+
+type config struct {
+	key string
+}
+
+// Option configures the interceptors.
+type Option func(*config)
+
+// WithMetadataKey sets the metadata key of the language. The key is lowercased, like grpc does with all metadata keys.
+func WithMetadataKey(key string) Option {
+	return func(c *config) {
+		c.key = strings.ToLower(key)
+	}
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{
+		key: DefaultMetadataKey,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// UnaryServerInterceptor adds the language in the incoming metadata to the context with lingua.WithLanguage.
+// If the metadata has no valid language, no language is added and the container uses its default language.
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(cfg.incoming(ctx), req)
+	}
+}
+
+// StreamServerInterceptor adds the language in the incoming metadata to the context of the stream, see UnaryServerInterceptor.
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: cfg.incoming(ss.Context())})
+	}
+}
+
+// UnaryClientInterceptor adds the language of the context, see lingua.FromCtx, to the outgoing metadata.
+// Nothing is added if the context has no language.
+func UnaryClientInterceptor(opts ...Option) grpc.UnaryClientInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		return invoker(cfg.outgoing(ctx), method, req, reply, cc, callOpts...)
+	}
+}
+
+// StreamClientInterceptor adds the language of the context to the outgoing metadata of the stream, see UnaryClientInterceptor.
+func StreamClientInterceptor(opts ...Option) grpc.StreamClientInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(cfg.outgoing(ctx), desc, cc, method, callOpts...)
+	}
+}
+
+// incoming adds the language of the incoming metadata to the ctx.
+func (c *config) incoming(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	values := md.Get(c.key)
+	if len(values) == 0 {
+		return ctx
+	}
+
+	return lingua.WithLanguage(ctx, values[0])
+}
+
+// outgoing adds the language of the ctx to the outgoing metadata.
+func (c *config) outgoing(ctx context.Context) context.Context {
+	lang := lingua.FromCtx(ctx)
+	if lang.Empty() {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, c.key, lang.String())
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
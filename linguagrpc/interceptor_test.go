@@ -0,0 +1,121 @@
+package linguagrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SLASH2NL/lingua"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServerInterceptors(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		md       metadata.MD
+		expected lingua.LanguageID
+	}{
+		{
+			name:     "default key",
+			md:       metadata.Pairs(DefaultMetadataKey, "nl-NL"),
+			expected: lingua.MustParseLanguage("nl-NL"),
+		},
+		{
+			name:     "custom key",
+			opts:     []Option{WithMetadataKey("X-Language")},
+			md:       metadata.Pairs("x-language", "zh-Hant-TW"),
+			expected: lingua.MustParseLanguage("zh-Hant-TW"),
+		},
+		{
+			name: "other key",
+			opts: []Option{WithMetadataKey("x-language")},
+			md:   metadata.Pairs(DefaultMetadataKey, "nl"),
+		},
+		{
+			name: "invalid",
+			md:   metadata.Pairs(DefaultMetadataKey, "invalid"),
+		},
+		{
+			name: "no metadata",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			_, err := UnaryServerInterceptor(tt.opts...)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				require.Equal(t, tt.expected, lingua.FromCtx(ctx))
+				return nil, nil
+			})
+			require.NoError(t, err)
+
+			err = StreamServerInterceptor(tt.opts...)(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(_ any, ss grpc.ServerStream) error {
+				require.Equal(t, tt.expected, lingua.FromCtx(ss.Context()))
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClientInterceptors(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		ctx      context.Context
+		key      string
+		expected []string
+	}{
+		{
+			name:     "default key",
+			ctx:      lingua.WithLanguage(context.Background(), "nl-NL"),
+			key:      DefaultMetadataKey,
+			expected: []string{"nl-NL"},
+		},
+		{
+			name:     "custom key",
+			opts:     []Option{WithMetadataKey("X-Language")},
+			ctx:      lingua.WithLanguage(context.Background(), "zh_Hant_TW"),
+			key:      "x-language",
+			expected: []string{"zh-Hant-TW"},
+		},
+		{
+			name: "no language",
+			ctx:  context.Background(),
+			key:  DefaultMetadataKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UnaryClientInterceptor(tt.opts...)(tt.ctx, "/test/Unary", nil, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				require.Equal(t, tt.expected, md.Get(tt.key))
+				return nil
+			})
+			require.NoError(t, err)
+
+			_, err = StreamClientInterceptor(tt.opts...)(tt.ctx, &grpc.StreamDesc{}, nil, "/test/Stream", func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				require.Equal(t, tt.expected, md.Get(tt.key))
+				return nil, nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Package linguahttp provides an http middleware that adds the language of a request to the context.
+package linguahttp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/SLASH2NL/lingua"
+)
+
+type ctxKey string
+
+var sourceKey = ctxKey("linguahttp.source")
+
+// Source resolves the language of a request, like from a query parameter or the Accept-Language header.
+type Source struct {
+	// Name identifies the source, it is returned by SourceFromCtx when the language is resolved by this source.
+	Name string
+
+	// Resolve returns the language of the request. It returns false if the request has no language for this source.
+	Resolve func(r *http.Request, c *lingua.Container) (lingua.LanguageID, bool)
+
+	// vary is the request header that the language depends on, it is added to the Vary header.
+	vary string
+}
+
+// Query resolves the language from the query parameter, like ?lang=nl.
+func Query(param string) Source {
+	return Source{
+		Name: "query",
+		Resolve: func(r *http.Request, c *lingua.Container) (lingua.LanguageID, bool) {
+			return c.Negotiate(r.URL.Query().Get(param))
+		},
+	}
+}
+
+// Cookie resolves the language from the cookie with the name.
+func Cookie(name string) Source {
+	return Source{
+		Name: "cookie",
+		Resolve: func(r *http.Request, c *lingua.Container) (lingua.LanguageID, bool) {
+			cookie, err := r.Cookie(name)
+			if err != nil {
+				return lingua.LanguageID{}, false
+			}
+
+			return c.Negotiate(cookie.Value)
+		},
+		vary: "Cookie",
+	}
+}
+
+// Header resolves the language from a custom request header, like X-Language.
+func Header(name string) Source {
+	return Source{
+		Name: "header",
+		Resolve: func(r *http.Request, c *lingua.Container) (lingua.LanguageID, bool) {
+			return c.Negotiate(r.Header.Get(name))
+		},
+		vary: http.CanonicalHeaderKey(name),
+	}
+}
+
+// AcceptLanguage resolves the language from the Accept-Language header, see lingua.Container.Negotiate.
+func AcceptLanguage() Source {
+	return Source{
+		Name: "accept-language",
+		Resolve: func(r *http.Request, c *lingua.Container) (lingua.LanguageID, bool) {
+			return c.Negotiate(r.Header.Get("Accept-Language"))
+		},
+		vary: "Accept-Language",
+	}
+}
+
+// Resolver resolves the language with the function, like from the settings of the user of the request.
+// The language is used as is, so it does not have to be a loaded language.
+func Resolver(name string, resolve func(r *http.Request) (lingua.LanguageID, bool)) Source {
+	return Source{
+		Name: name,
+		Resolve: func(r *http.Request, _ *lingua.Container) (lingua.LanguageID, bool) {
+			return resolve(r)
+		},
+	}
+}
+
+type config struct {
+	sources         []Source
+	contentLanguage bool
+	vary            bool
+}
+
+// Option configures the Middleware.
+type Option func(*config)
+
+// WithSources sets the sources that are tried in order until one resolves the language.
+// The default is the Accept-Language header.
+func WithSources(sources ...Source) Option {
+	return func(c *config) {
+		c.sources = sources
+	}
+}
+
+// WithContentLanguage sets the Content-Language response header to the language that is used for the messages.
+func WithContentLanguage() Option {
+	return func(c *config) {
+		c.contentLanguage = true
+	}
+}
+
+// WithVary adds the request headers of the sources, like Accept-Language, to the Vary response header,
+// so caches store a response per language.
+func WithVary() Option {
+	return func(c *config) {
+		c.vary = true
+	}
+}
+
+// Middleware adds the language of the request to the context with lingua.WithLanguage.
+// If no source resolves the language, no language is added and the container uses its default language.
+func Middleware(c *lingua.Container, opts ...Option) func(http.Handler) http.Handler {
+	cfg := &config{
+		sources: []Source{AcceptLanguage()},
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var vary []string
+	for _, source := range cfg.sources {
+		if source.vary != "" {
+			vary = append(vary, source.vary)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			for _, source := range cfg.sources {
+				lang, ok := source.Resolve(r, c)
+				if !ok {
+					continue
+				}
+
+				ctx = lingua.WithLanguage(ctx, lang.String())
+				ctx = context.WithValue(ctx, sourceKey, source.Name)
+
+				break
+			}
+
+			if cfg.contentLanguage {
+				if lang := c.ScopedLanguage(ctx); !lang.Empty() {
+					w.Header().Set("Content-Language", lang.String())
+				}
+			}
+
+			if cfg.vary && len(vary) > 0 {
+				w.Header().Add("Vary", strings.Join(vary, ", "))
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// SourceFromCtx returns the name of the source that resolved the language of the request, like "query" or "accept-language".
+// It returns an empty string if no source resolved the language.
+func SourceFromCtx(ctx context.Context) string {
+	source, _ := ctx.Value(sourceKey).(string)
+	return source
+}
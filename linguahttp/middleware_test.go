@@ -0,0 +1,127 @@
+package linguahttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SLASH2NL/lingua"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func newContainer(t *testing.T) *lingua.Container {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "en.yaml", []byte(`hello: "Hello"`), 0644))
+	require.NoError(t, afero.WriteFile(fs, "nl.yaml", []byte(`hello: "Hallo"`), 0644))
+	require.NoError(t, afero.WriteFile(fs, "fr.yaml", []byte(`hello: "Bonjour"`), 0644))
+
+	c, err := lingua.ContainerFromFs(fs, lingua.WithDefaultLanguage(lingua.MustParseLanguage("en")))
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestMiddleware(t *testing.T) {
+	c := newContainer(t)
+
+	user := Resolver("user", func(r *http.Request) (lingua.LanguageID, bool) {
+		if r.Header.Get("Authorization") == "" {
+			return lingua.LanguageID{}, false
+		}
+
+		return lingua.MustParseLanguage("fr"), true
+	})
+
+	handler := Middleware(c,
+		WithSources(Query("lang"), Cookie("lang"), Header("X-Language"), user, AcceptLanguage()),
+		WithContentLanguage(),
+		WithVary(),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Source", SourceFromCtx(r.Context()))
+		_, _ = w.Write([]byte(c.Message(r.Context(), "hello", nil)))
+	}))
+
+	tests := []struct {
+		name     string
+		request  func(r *http.Request)
+		expected string
+		source   string
+		language string
+	}{
+		{
+			name:     "query",
+			request:  func(r *http.Request) { r.URL.RawQuery = "lang=nl"; r.Header.Set("Accept-Language", "fr") },
+			expected: "Hallo",
+			source:   "query",
+			language: "nl",
+		},
+		{
+			name:     "cookie",
+			request:  func(r *http.Request) { r.AddCookie(&http.Cookie{Name: "lang", Value: "nl-BE"}) },
+			expected: "Hallo",
+			source:   "cookie",
+			language: "nl",
+		},
+		{
+			name:     "header",
+			request:  func(r *http.Request) { r.Header.Set("X-Language", "fr") },
+			expected: "Bonjour",
+			source:   "header",
+			language: "fr",
+		},
+		{
+			name:     "resolver",
+			request:  func(r *http.Request) { r.Header.Set("Authorization", "token") },
+			expected: "Bonjour",
+			source:   "user",
+			language: "fr",
+		},
+		{
+			name:     "accept language",
+			request:  func(r *http.Request) { r.Header.Set("Accept-Language", "fr;q=0.1, nl;q=0.9") },
+			expected: "Hallo",
+			source:   "accept-language",
+			language: "nl",
+		},
+		{
+			name:     "unsupported language",
+			request:  func(r *http.Request) { r.URL.RawQuery = "lang=de" },
+			expected: "Hello",
+			language: "en",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			tt.request(r)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			require.Equal(t, tt.expected, w.Body.String())
+			require.Equal(t, tt.source, w.Header().Get("X-Source"))
+			require.Equal(t, tt.language, w.Header().Get("Content-Language"))
+			require.Equal(t, "Cookie, X-Language, Accept-Language", w.Header().Get("Vary"))
+		})
+	}
+}
+
+func TestMiddlewareDefaults(t *testing.T) {
+	c := newContainer(t)
+
+	handler := Middleware(c)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(lingua.FromCtx(r.Context()).String()))
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Language", "nl-NL, en;q=0.5")
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	require.Equal(t, "nl", w.Body.String())
+	require.Empty(t, w.Header().Get("Content-Language"))
+	require.Empty(t, w.Header().Get("Vary"))
+}
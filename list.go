@@ -0,0 +1,138 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type listStyle int
+
+const (
+	listStyleConjunction listStyle = iota
+	listStyleDisjunction
+	listStyleUnit
+)
+
+var listStyles = map[string]listStyle{
+	"conjunction": listStyleConjunction,
+	"disjunction": listStyleDisjunction,
+	"unit":        listStyleUnit,
+}
+
+// listPatterns holds the CLDR list patterns of a style. The {0} and {1} are replaced by the items.
+// The start pattern is the same as the middle pattern for all supported languages.
+type listPatterns struct {
+	middle string
+	end    string
+	two    string
+}
+
+// listTransformer formats a slice as a list, like "a, b and c".
+type listTransformer struct {
+	c     *Container
+	style listStyle
+
+	// translate translates the items of type Key to the message of the key before they are joined.
+	translate bool
+}
+
+// newListTransformer returns the factory of the list transformer.
+// The transformer accepts a style of `conjunction`, `disjunction` or `unit` and `translate` to translate Key items.
+func (c *Container) newListTransformer(args []string) (Transformer, error) {
+	t := listTransformer{
+		c:     c,
+		style: listStyleConjunction,
+	}
+
+	for _, arg := range args {
+		if arg == "translate" {
+			t.translate = true
+			continue
+		}
+
+		style, ok := listStyles[arg]
+		if !ok {
+			return nil, fmt.Errorf("unknown argument %q for list transformer", arg)
+		}
+
+		t.style = style
+	}
+
+	return t, nil
+}
+
+func (t listTransformer) Transform(ctx context.Context, lang LanguageID, value any, replacements map[string]any) any {
+	return t.transform(t.c.newFormatter(ctx, lang, "", replacements), value)
+}
+
+// transform formats the list with the formatter of the message, so translated items are formatted like references:
+// a cycle is formatted as the key and a missing replacement is reported for the message.
+func (t listTransformer) transform(f *formatter, value any) any {
+	valueOf := reflect.ValueOf(value)
+	if valueOf.Kind() != reflect.Slice && valueOf.Kind() != reflect.Array {
+		return formatReplacement(value)
+	}
+
+	items := make([]string, 0, valueOf.Len())
+	for i := 0; i < valueOf.Len(); i++ {
+		item := valueOf.Index(i).Interface()
+
+		if key, ok := item.(Key); ok && t.translate {
+			// Translated items can use the replacements of the message.
+			items = append(items, f.reference(key))
+			continue
+		}
+
+		items = append(items, formatReplacement(item))
+	}
+
+	return formatList(f.lang, t.style, items)
+}
+
+func (t listTransformer) hasLocale(lang LanguageID) bool {
+	_, ok := listLocaleFor(lang)
+	return ok
+}
+
+// listLocaleFor returns the list patterns of the language, the language without region or en.
+// The ok is false if en is used for another language.
+func listLocaleFor(lang LanguageID) (locale [3]listPatterns, ok bool) {
+	if l, ok := listLocales[lang.String()]; ok {
+		return l, true
+	}
+
+	if l, ok := listLocales[lang.Language]; ok {
+		return l, true
+	}
+
+	return listLocales["en"], false
+}
+
+// formatList joins the items with the list patterns of the language.
+func formatList(lang LanguageID, style listStyle, items []string) string {
+	locale, _ := listLocaleFor(lang)
+	patterns := locale[style]
+
+	join := func(pattern string, a, b string) string {
+		return strings.NewReplacer("{0}", a, "{1}", b).Replace(pattern)
+	}
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return join(patterns.two, items[0], items[1])
+	}
+
+	// Join the items from the end, so the end pattern is used for the last two items.
+	list := join(patterns.end, items[len(items)-2], items[len(items)-1])
+	for i := len(items) - 3; i >= 0; i-- {
+		list = join(patterns.middle, items[i], list)
+	}
+
+	return list
+}
@@ -0,0 +1,101 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerList(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, lang := range []string{"en-US", "en-GB", "nl", "de"} {
+		mustWriteYaml(t, fs, lang+".yaml", `
+and: ":items|list"
+or: ":items|list(disjunction)"
+unit: ":items|list(unit)"
+`)
+	}
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	cases := []struct {
+		lang   string
+		key    Key
+		value  any
+		expect string
+	}{
+		{lang: "en-US", key: "and", value: []string{"a", "b", "c"}, expect: "a, b, and c"},
+		{lang: "en-GB", key: "and", value: []string{"a", "b", "c"}, expect: "a, b and c"},
+		{lang: "nl", key: "and", value: []string{"a", "b", "c", "d"}, expect: "a, b, c en d"},
+		{lang: "nl", key: "and", value: []string{"a", "b"}, expect: "a en b"},
+		{lang: "nl", key: "and", value: []string{"a"}, expect: "a"},
+		{lang: "nl", key: "and", value: []string{}, expect: ""},
+		{lang: "en-US", key: "or", value: []string{"a", "b"}, expect: "a or b"},
+		{lang: "de", key: "or", value: []string{"a", "b", "c"}, expect: "a, b oder c"},
+		{lang: "en-US", key: "unit", value: []string{"3 ft", "7 in"}, expect: "3 ft, 7 in"},
+		{lang: "en-US", key: "and", value: []int{1, 2, 3}, expect: "1, 2, and 3"},
+		{lang: "en-US", key: "and", value: "single", expect: "single"},
+	}
+
+	for _, tc := range cases {
+		ctx := WithLanguage(context.Background(), tc.lang)
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, map[string]any{"items": tc.value}), "%s %s %v", tc.lang, tc.key, tc.value)
+	}
+}
+
+func TestContainerListMissingLocale(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "it.yaml", `
+and: ":items|list"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.ErrorIs(t, err, ErrMissingLocale)
+
+	// it has no list patterns, so the patterns of en are used.
+	c, err := ContainerFromFs(fs, WithLenientLoad())
+	require.EqualError(t, err, `missing locale data in file "it.yaml": the list transformer of message "and" has no data for it, the data of en is used`)
+
+	ctx := WithLanguage(context.Background(), "it")
+	require.Equal(t, "a, b, and c", c.Message(ctx, "and", map[string]any{"items": []string{"a", "b", "c"}}))
+}
+
+func TestContainerListTranslate(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "nl.yaml", `
+missing: "Vul :fields|list(translate) in"
+raw: "Vul :fields|list in"
+field.email: "e-mailadres"
+field.name: "naam van :user"
+`)
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "nl")
+	fields := []Key{"field.email", "field.name", "field.phone"}
+
+	require.Equal(t, "Vul e-mailadres, naam van john en field.phone in", c.Message(ctx, "missing", map[string]any{"fields": fields, "user": "john"}))
+	require.Equal(t, "Vul field.email, field.name en field.phone in", c.Message(ctx, "raw", map[string]any{"fields": fields}))
+
+	// A translated item that is the message itself is formatted as its key, like a reference cycle.
+	require.Equal(t, "Vul missing en e-mailadres in", c.Message(ctx, "missing", map[string]any{"fields": []Key{"missing", "field.email"}}))
+
+	// A missing replacement in a translated item is reported for the message.
+	c, err = ContainerFromFs(fs, WithMissingReplacementPolicy(MissingReplacementError))
+	require.NoError(t, err)
+
+	message, err := c.Format(ctx, "missing", map[string]any{"fields": fields})
+	require.ErrorIs(t, err, ErrMissingReplacement)
+	require.Equal(t, "Vul e-mailadres, naam van :user en field.phone in", message)
+
+	mustWriteYaml(t, fs, "nl.yaml", `
+missing: ":fields|list(conjunction, sorted)"
+`)
+
+	_, err = ContainerFromFs(fs)
+	require.Error(t, err)
+}
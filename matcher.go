@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	// defaultMatcher matches a translation file with the format: en.yaml or en-US.yaml.
-	defaultMatcher = NewRegexMatcher(regexp.MustCompile(`^([a-z]{2}(?:[-][A-Z]{2})?)\.yaml$`))
+	// defaultMatcher matches a translation file with a BCP 47 language tag as name,
+	// like en.yaml, en-US.yaml, zh-Hant.yaml, zh-Hant-TW.yaml, es-419.yaml or de-CH-1996.yaml.
+	defaultMatcher = NewRegexMatcher(regexp.MustCompile(`^([a-z]{2,3}(?:-[A-Z][a-z]{3})?(?:-(?:[A-Z]{2}|\d{3}))?(?:-(?:[a-z0-9]{5,8}|\d[a-z0-9]{3}))*)\.yaml$`))
 )
 
 // FileMatcher is an interface that is used to check if a given file in a directory structure
@@ -0,0 +1,66 @@
+package lingua
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// Negotiate returns the loaded language that best matches an Accept-Language header, like "fr;q=0.1, nl;q=0.9".
+// The languages in the header are ranked by their quality weight and matched with a language.Matcher,
+// so en-AU matches en-GB and zh-TW matches zh-Hant. It returns false if the header is invalid or no loaded language matches.
+func (c *Container) Negotiate(acceptLanguage string) (LanguageID, bool) {
+	if c.matcher == nil {
+		return LanguageID{}, false
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return LanguageID{}, false
+	}
+
+	_, index, conf := c.matcher.Match(tags...)
+	if conf == language.No {
+		return LanguageID{}, false
+	}
+
+	return c.supported[index], true
+}
+
+// WithAcceptLanguage adds the loaded language that best matches the Accept-Language header to the ctx, see Negotiate.
+// If no loaded language matches, no language is added to the context and Container.Message uses the default language.
+func (c *Container) WithAcceptLanguage(ctx context.Context, acceptLanguage string) context.Context {
+	lang, ok := c.Negotiate(acceptLanguage)
+	if !ok {
+		return ctx
+	}
+
+	return toCtx(ctx, lang)
+}
+
+// newMatcher sets the matcher for the loaded languages. The default language is the first supported language,
+// so it is preferred when multiple languages match equally well.
+func (c *Container) newMatcher(loaded []LanguageID) {
+	c.supported = make([]LanguageID, 0, len(loaded))
+	if _, ok := c.messages[c.defaultLanguage]; ok {
+		c.supported = append(c.supported, c.defaultLanguage)
+	}
+
+	for _, lang := range loaded {
+		if lang != c.defaultLanguage {
+			c.supported = append(c.supported, lang)
+		}
+	}
+
+	if len(c.supported) == 0 {
+		c.matcher = nil
+		return
+	}
+
+	tags := make([]language.Tag, 0, len(c.supported))
+	for _, lang := range c.supported {
+		tags = append(tags, lang.Tag())
+	}
+
+	c.matcher = language.NewMatcher(tags)
+}
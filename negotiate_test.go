@@ -0,0 +1,51 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerNegotiate(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en-US.yaml", `hello: "Hello"`)
+	mustWriteYaml(t, fs, "en-GB.yaml", `hello: "Hello"`)
+	mustWriteYaml(t, fs, "fr.yaml", `hello: "Bonjour"`)
+	mustWriteYaml(t, fs, "nl.yaml", `hello: "Hallo"`)
+	mustWriteYaml(t, fs, "zh-Hant.yaml", `hello: "妳好"`)
+
+	c, err := ContainerFromFs(fs, WithDefaultLanguage(MustParseLanguage("en-US")))
+	require.NoError(t, err)
+
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "fr;q=0.1, nl;q=0.9", expected: "nl"},
+		{header: "de, nl-BE;q=0.8, fr;q=0.5", expected: "nl"},
+		{header: "en-AU", expected: "en-GB"},
+		{header: "en", expected: "en-US"},
+		{header: "zh-TW", expected: "zh-Hant"},
+		{header: "nl;q=0, fr", expected: "fr"},
+		{header: "de", expected: ""},
+		{header: "", expected: ""},
+		{header: "invalid;;q=x", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			lang, ok := c.Negotiate(tt.header)
+			require.Equal(t, tt.expected != "", ok)
+			require.Equal(t, tt.expected, lang.String())
+		})
+	}
+
+	ctx := c.WithAcceptLanguage(context.Background(), "fr;q=0.1, nl;q=0.9")
+	require.Equal(t, "Hallo", c.Message(ctx, "hello", nil))
+
+	ctx = c.WithAcceptLanguage(context.Background(), "de")
+	require.True(t, FromCtx(ctx).Empty())
+	require.Equal(t, "Hello", c.Message(ctx, "hello", nil))
+}
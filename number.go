@@ -0,0 +1,205 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+type numberStyle int
+
+const (
+	numberStyleDecimal numberStyle = iota
+	numberStylePercent
+	numberStyleCompact
+)
+
+// numberTransformer formats the value as a number using the separators and grouping of the language.
+type numberTransformer struct {
+	style numberStyle
+
+	// decimals is the exact number of decimals to use.
+	// A negative value uses the default number of decimals of the style.
+	decimals int
+}
+
+// newNumberTransformer creates a number transformer with arguments like `percent`, `compact`, `integer` or `decimals=2`.
+func newNumberTransformer(args []string) (Transformer, error) {
+	t := numberTransformer{
+		decimals: -1,
+	}
+
+	for _, arg := range args {
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		switch {
+		case name == "percent" && !hasValue:
+			t.style = numberStylePercent
+		case name == "compact" && !hasValue:
+			t.style = numberStyleCompact
+		case name == "integer" && !hasValue:
+			t.decimals = 0
+		case name == "decimals" && hasValue:
+			decimals, err := strconv.Atoi(value)
+			if err != nil || decimals < 0 {
+				return nil, fmt.Errorf("invalid number of decimals %q for number transformer", value)
+			}
+
+			t.decimals = decimals
+		default:
+			return nil, fmt.Errorf("unknown argument %q for number transformer", arg)
+		}
+	}
+
+	return t, nil
+}
+
+func (t numberTransformer) Transform(_ context.Context, lang LanguageID, value any, _ map[string]any) any {
+	text := formatNumber(lang, value, t)
+	if n, ok := toNumber(value); ok {
+		return formattedNumber{number: n, text: text}
+	}
+
+	return text
+}
+
+// formattedNumber is a number formatted by the number transformer.
+// It keeps the number, so a plural transformer later in the chain can select a case, like `:count|number|plural(...)`.
+type formattedNumber struct {
+	number any
+	text   string
+}
+
+func (n formattedNumber) String() string {
+	return n.text
+}
+
+// compactSuffixes holds the short compact suffixes for thousands, millions, billions and trillions per language.
+// An empty suffix means the language does not compact numbers of that size.
+var compactSuffixes = map[string][4]string{
+	"en": {"K", "M", "B", "T"},
+	"nl": {"K", "\u00a0mln.", "\u00a0mld.", "\u00a0bln."},
+	"de": {"", "\u00a0Mio.", "\u00a0Mrd.", "\u00a0Bio."},
+	"fr": {"\u00a0k", "\u00a0M", "\u00a0Md", "\u00a0Bn"},
+	"es": {"\u00a0mil", "\u00a0M", "\u00a0mil\u00a0M", "\u00a0B"},
+}
+
+// formatNumber formats the value as a number using the separators and grouping of the language.
+// Values that are not a number are returned as a formatted replacement.
+func formatNumber(lang LanguageID, value any, t numberTransformer) string {
+	n, ok := toNumber(value)
+	if !ok {
+		return formatReplacement(value)
+	}
+
+	p := message.NewPrinter(lang.Tag())
+
+	var opts []number.Option
+	if t.decimals >= 0 {
+		opts = append(opts, number.MinFractionDigits(t.decimals), number.MaxFractionDigits(t.decimals))
+	}
+
+	switch t.style {
+	case numberStylePercent:
+		return p.Sprint(number.Percent(n, opts...))
+	case numberStyleCompact:
+		return formatCompact(p, lang, n, t.decimals)
+	}
+
+	return p.Sprint(number.Decimal(n, opts...))
+}
+
+// formatCompact formats large numbers in the short compact form of the language, like 1.2K or 3 mln.
+func formatCompact(p *message.Printer, lang LanguageID, n any, decimals int) string {
+	f, _ := n.(float64)
+	switch v := n.(type) {
+	case int64:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	}
+
+	suffixes, ok := compactSuffixes[lang.Language]
+	if !ok {
+		suffixes = compactSuffixes["en"]
+	}
+
+	// Find the largest magnitude the language has a suffix for.
+	unit := -1
+	for i := len(suffixes) - 1; i >= 0; i-- {
+		if math.Abs(f) >= math.Pow(1000, float64(i+1)) && suffixes[i] != "" {
+			unit = i
+			break
+		}
+	}
+
+	if unit == -1 {
+		return p.Sprint(number.Decimal(n))
+	}
+
+	f /= math.Pow(1000, float64(unit+1))
+	f = roundCompact(f, decimals)
+
+	// Round before the suffix is final, so 999999 becomes 1M instead of 1000K.
+	if math.Abs(f) >= 1000 && unit+1 < len(suffixes) && suffixes[unit+1] != "" {
+		unit++
+		f = roundCompact(f/1000, decimals)
+	}
+
+	return p.Sprint(number.Decimal(f, number.MaxFractionDigits(compactDecimals(f, decimals)))) + suffixes[unit]
+}
+
+// compactDecimals returns the decimals of a compact number. Without decimals, small values like 1.2K have 2 significant
+// digits and values like 12K have no decimals.
+func compactDecimals(f float64, decimals int) int {
+	if decimals >= 0 {
+		return decimals
+	}
+
+	if math.Abs(f) < 10 {
+		return 1
+	}
+
+	return 0
+}
+
+// roundCompact rounds the compact number to its decimals, half to even like the printer.
+func roundCompact(f float64, decimals int) float64 {
+	pow := math.Pow(10, float64(compactDecimals(f, decimals)))
+	return math.RoundToEven(f*pow) / pow
+}
+
+// toNumber converts the value to an int64, uint64 or float64.
+// Strings are parsed as a number.
+func toNumber(value any) (any, bool) {
+	if n, ok := value.(formattedNumber); ok {
+		return n.number, true
+	}
+
+	valueOf := reflect.ValueOf(value)
+
+	switch valueOf.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return valueOf.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return valueOf.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return valueOf.Float(), true
+	case reflect.String:
+		if i, err := strconv.ParseInt(valueOf.String(), 10, 64); err == nil {
+			return i, true
+		}
+
+		if f, err := strconv.ParseFloat(valueOf.String(), 64); err == nil {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
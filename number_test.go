@@ -0,0 +1,76 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerNumber(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, lang := range []string{"en", "nl", "de"} {
+		mustWriteYaml(t, fs, lang+".yaml", `
+number: ":n|number"
+decimals: ":n|number(decimals=2)"
+integer: ":n|number(integer)"
+percent: ":n|number(percent)"
+compact: ":n|number(compact)"
+`)
+	}
+
+	c, err := ContainerFromFs(fs)
+	require.NoError(t, err)
+
+	cases := []struct {
+		lang   string
+		key    Key
+		value  any
+		expect string
+	}{
+		{lang: "en", key: "number", value: 1234.5, expect: "1,234.5"},
+		{lang: "nl", key: "number", value: 1234.5, expect: "1.234,5"},
+		{lang: "nl", key: "number", value: 1234567, expect: "1.234.567"},
+		{lang: "nl", key: "number", value: "1234.5", expect: "1.234,5"},
+		{lang: "nl", key: "number", value: "not a number", expect: "not a number"},
+		{lang: "en", key: "decimals", value: 1234.5, expect: "1,234.50"},
+		{lang: "nl", key: "decimals", value: 1234.5, expect: "1.234,50"},
+		{lang: "nl", key: "integer", value: 1234.4, expect: "1.234"},
+		{lang: "en", key: "percent", value: 0.25, expect: "25%"},
+		{lang: "de", key: "percent", value: 0.25, expect: "25\u00a0%"},
+		{lang: "en", key: "compact", value: 1500, expect: "1.5K"},
+		{lang: "en", key: "compact", value: 25300000, expect: "25M"},
+		{lang: "en", key: "compact", value: 999, expect: "999"},
+		{lang: "nl", key: "compact", value: 1200000, expect: "1,2\u00a0mln."},
+		{lang: "de", key: "compact", value: 1500, expect: "1.500"},
+		{lang: "en", key: "compact", value: 9999, expect: "10K"},
+		{lang: "en", key: "compact", value: 999499, expect: "999K"},
+		{lang: "en", key: "compact", value: 999999, expect: "1M"},
+		{lang: "en", key: "compact", value: 999999999, expect: "1B"},
+		{lang: "en", key: "compact", value: -999999, expect: "-1M"},
+		{lang: "nl", key: "compact", value: 999999, expect: "1\u00a0mln."},
+		{lang: "de", key: "compact", value: 999999, expect: "999.999"},
+		{lang: "de", key: "compact", value: 999999999, expect: "1\u00a0Mrd."},
+	}
+
+	for _, tc := range cases {
+		ctx := WithLanguage(context.Background(), tc.lang)
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, map[string]any{"n": tc.value}), "%s %s %v", tc.lang, tc.key, tc.value)
+	}
+
+	raw := c.Raw()[LanguageID{Language: "en"}]
+	require.Equal(t, ":n|number(decimals=2)", raw["decimals"])
+	require.Equal(t, ":n|number(integer)", raw["integer"])
+	require.Equal(t, ":n|number(percent)", raw["percent"])
+}
+
+func TestContainerNumberInvalid(t *testing.T) {
+	for _, message := range []string{":n|number(unknown)", ":n|number(decimals=x)", ":n|number(decimals=-1)"} {
+		fs := afero.NewMemMapFs()
+		mustWriteYaml(t, fs, "en.yaml", "total: \""+message+"\"")
+
+		_, err := ContainerFromFs(fs)
+		require.Error(t, err, message)
+	}
+}
@@ -0,0 +1,116 @@
+package lingua
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/SLASH2NL/lingua/internal/parser"
+	"golang.org/x/text/feature/plural"
+)
+
+// pluralCategories maps the plural forms of golang.org/x/text to the CLDR category keywords used in messages.
+var pluralCategories = map[plural.Form]string{
+	plural.Other: "other",
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+}
+
+// pluralCategory returns the CLDR plural category of the decimal number in value for the given language.
+// Values that are not a number are categorized as "other".
+func pluralCategory(rules *plural.Rules, lang LanguageID, value string) string {
+	value = strings.TrimLeft(value, "+-")
+
+	integer, fraction, _ := strings.Cut(value, ".")
+	if integer == "" {
+		return pluralCategories[plural.Other]
+	}
+
+	digits := make([]byte, 0, len(integer)+len(fraction))
+	for _, r := range integer + fraction {
+		if r < '0' || r > '9' {
+			return pluralCategories[plural.Other]
+		}
+
+		digits = append(digits, byte(r-'0'))
+	}
+
+	return pluralCategories[rules.MatchDigits(lang.Tag(), digits, len(integer), len(fraction))]
+}
+
+// selectPluralCase selects the ops of the matching case for the count in value.
+// The rules determine if the cardinal (plural) or ordinal categories of the language are used.
+// It also returns the count to use for a PluralCountOp.
+func selectPluralCase(rules *plural.Rules, lang LanguageID, value any, cases []parser.PluralCase, other []any) ([]any, string) {
+	n, ok := toNumber(value)
+	if !ok {
+		// A value that is not a number only matches the other case.
+		return other, formatReplacement(value)
+	}
+
+	// The decimal representation determines the plural category. A string keeps its visible decimals, like "1.50".
+	digits, isString := value.(string)
+	if !isString {
+		digits = formatDecimal(n)
+	}
+
+	// A number formatted by the number transformer is written as formatted.
+	count := digits
+	if formatted, ok := value.(formattedNumber); ok {
+		count = formatted.text
+	}
+
+	// Exact and range cases take priority over the plural category of the language.
+	// They only match whole numbers, so 2.5 does not match =2.
+	if whole, ok := wholeNumber(n); ok {
+		for _, c := range cases {
+			if c.Match(whole) {
+				return c.Ops, count
+			}
+		}
+	}
+
+	category := pluralCategory(rules, lang, digits)
+	for _, c := range cases {
+		if c.Type == parser.OpPluralCaseCategory && c.Category == category {
+			return c.Ops, count
+		}
+	}
+
+	return other, count
+}
+
+// formatDecimal formats a number of toNumber as a decimal without exponent, like 2.5 or 1500.
+func formatDecimal(n any) string {
+	switch v := n.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	return ""
+}
+
+// wholeNumber returns the number of toNumber as an int if it has no fraction.
+func wholeNumber(n any) (int, bool) {
+	switch v := n.(type) {
+	case int64:
+		return int(v), true
+	case uint64:
+		if v <= math.MaxInt {
+			return int(v), true
+		}
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) <= 1<<53 {
+			return int(v), true
+		}
+	}
+
+	return 0, false
+}
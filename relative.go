@@ -0,0 +1,186 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+var nowKey = ctxKey("lingua.now")
+
+// WithNow adds the reference time to the ctx that is used by the relative transformer.
+// This makes relative times deterministic, for example in tests.
+func WithNow(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, nowKey, now)
+}
+
+// NowFromCtx returns the reference time from the ctx or the current time if no reference time is set.
+func NowFromCtx(ctx context.Context) time.Time {
+	if now, ok := ctx.Value(nowKey).(time.Time); ok {
+		return now
+	}
+
+	return time.Now()
+}
+
+type relativeStyle int
+
+const (
+	relativeStyleLong relativeStyle = iota
+	relativeStyleShort
+)
+
+var relativeStyles = map[string]relativeStyle{
+	"long":  relativeStyleLong,
+	"short": relativeStyleShort,
+}
+
+// relativeTransformer formats a time.Time or time.Duration value relative to now, like "3 days ago" or "in 2 hours".
+type relativeTransformer struct {
+	style relativeStyle
+}
+
+// newRelativeTransformer creates a relative transformer with an optional style of `long` or `short`.
+func newRelativeTransformer(args []string) (Transformer, error) {
+	t := relativeTransformer{
+		style: relativeStyleLong,
+	}
+
+	if len(args) > 1 {
+		return nil, fmt.Errorf("expected a single style for relative transformer")
+	}
+
+	if len(args) == 0 {
+		return t, nil
+	}
+
+	style, ok := relativeStyles[args[0]]
+	if !ok {
+		return nil, fmt.Errorf("unknown style %q for relative transformer", args[0])
+	}
+
+	t.style = style
+
+	return t, nil
+}
+
+func (t relativeTransformer) Transform(ctx context.Context, lang LanguageID, value any, _ map[string]any) any {
+	return formatRelative(lang, NowFromCtx(ctx), value, t)
+}
+
+// relativeUnit is a unit of a relative time, ordered from large to small.
+type relativeUnit int
+
+const (
+	relativeYear relativeUnit = iota
+	relativeMonth
+	relativeWeek
+	relativeDay
+	relativeHour
+	relativeMinute
+	relativeSecond
+)
+
+// relativeUnitDurations holds the duration of every unit. Months and years are approximated.
+var relativeUnitDurations = [7]time.Duration{
+	relativeYear:   365 * 24 * time.Hour,
+	relativeMonth:  30 * 24 * time.Hour,
+	relativeWeek:   7 * 24 * time.Hour,
+	relativeDay:    24 * time.Hour,
+	relativeHour:   time.Hour,
+	relativeMinute: time.Minute,
+	relativeSecond: time.Second,
+}
+
+func (t relativeTransformer) hasLocale(lang LanguageID) bool {
+	_, ok := relativeLocaleFor(lang)
+	return ok
+}
+
+// relativePatterns holds the CLDR relative time patterns of a unit per plural category.
+// The {0} is replaced by the count. Missing categories use "other".
+type relativePatterns struct {
+	future map[string]string
+	past   map[string]string
+}
+
+// relativeLocale holds the CLDR relative time data of a language.
+type relativeLocale struct {
+	// now is used for durations of less than a second.
+	now string
+
+	// The units are indexed by relativeStyle and relativeUnit.
+	units [2][7]relativePatterns
+}
+
+// relativeLocaleFor returns the relative time data of the language or en.
+// The ok is false if en is used for another language.
+func relativeLocaleFor(lang LanguageID) (locale *relativeLocale, ok bool) {
+	if l, ok := relativeLocales[lang.Language]; ok {
+		return l, true
+	}
+
+	return relativeLocales["en"], false
+}
+
+// formatRelative formats a time.Time or time.Duration value relative to now, like "3 days ago" or "in 2 hours".
+// A duration is the offset from now, so a negative duration is in the past.
+// The largest unit that fits the offset is used and the count is rounded down.
+// Values that are not a time or duration are returned as a formatted replacement.
+func formatRelative(lang LanguageID, now time.Time, value any, t relativeTransformer) string {
+	var d time.Duration
+
+	switch v := value.(type) {
+	case time.Time:
+		d = v.Sub(now)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+
+		d = v.Sub(now)
+	case time.Duration:
+		d = v
+	default:
+		return formatReplacement(value)
+	}
+
+	locale, _ := relativeLocaleFor(lang)
+
+	past := d < 0
+	if past {
+		d = -d
+	}
+
+	unit := relativeSecond
+	for u, duration := range relativeUnitDurations {
+		if d >= duration {
+			unit = relativeUnit(u)
+			break
+		}
+	}
+
+	count := int64(d / relativeUnitDurations[unit])
+	if count == 0 {
+		return locale.now
+	}
+
+	patterns := locale.units[t.style][unit].future
+	if past {
+		patterns = locale.units[t.style][unit].past
+	}
+
+	pattern, ok := patterns[pluralCategory(plural.Cardinal, lang, formatReplacement(count))]
+	if !ok {
+		pattern = patterns[pluralCategories[plural.Other]]
+	}
+
+	p := message.NewPrinter(lang.Tag())
+
+	return strings.Replace(pattern, "{0}", p.Sprint(number.Decimal(count)), 1)
+}
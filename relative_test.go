@@ -0,0 +1,83 @@
+package lingua
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerRelative(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, lang := range []string{"en", "nl", "de", "fr", "pl", "sv"} {
+		mustWriteYaml(t, fs, lang+".yaml", `
+posted: ":at|relative"
+short: ":at|relative(short)"
+`)
+	}
+
+	// sv has no relative time data, so it is formatted with the data of en.
+	c, err := ContainerFromFs(fs, WithLenientLoad())
+	require.ErrorIs(t, err, ErrMissingLocale)
+	require.ErrorContains(t, err, `the relative transformer of message "posted" has no data for sv`)
+
+	now := time.Date(2025, time.March, 7, 14, 5, 0, 0, time.UTC)
+
+	cases := []struct {
+		lang   string
+		key    Key
+		value  any
+		expect string
+	}{
+		{lang: "en", key: "posted", value: now.Add(-3 * 24 * time.Hour), expect: "3 days ago"},
+		{lang: "en", key: "posted", value: now.Add(-25 * time.Hour), expect: "1 day ago"},
+		{lang: "en", key: "posted", value: now.Add(2*time.Hour + 30*time.Minute), expect: "in 2 hours"},
+		{lang: "en", key: "posted", value: now.Add(1 * time.Minute), expect: "in 1 minute"},
+		{lang: "en", key: "posted", value: now.Add(-400 * 24 * time.Hour), expect: "1 year ago"},
+		{lang: "en", key: "posted", value: now.Add(-14 * 24 * time.Hour), expect: "2 weeks ago"},
+		{lang: "en", key: "posted", value: now.Add(-500 * time.Millisecond), expect: "now"},
+		{lang: "en", key: "posted", value: -90 * time.Second, expect: "1 minute ago"},
+		{lang: "en", key: "posted", value: 45 * time.Second, expect: "in 45 seconds"},
+		{lang: "en", key: "short", value: now.Add(-5 * time.Minute), expect: "5 min. ago"},
+		{lang: "en", key: "posted", value: "yesterday", expect: "yesterday"},
+		{lang: "nl", key: "posted", value: now.Add(-3 * 24 * time.Hour), expect: "3 dagen geleden"},
+		{lang: "nl", key: "posted", value: now.Add(time.Hour), expect: "over 1 uur"},
+		{lang: "nl", key: "posted", value: now.Add(-60 * 24 * time.Hour), expect: "2 maanden geleden"},
+		{lang: "de", key: "posted", value: now.Add(-24 * time.Hour), expect: "vor 1 Tag"},
+		{lang: "de", key: "posted", value: now.Add(5 * 24 * time.Hour), expect: "in 5 Tagen"},
+		{lang: "de", key: "short", value: now.Add(-3 * time.Hour), expect: "vor 3 Std."},
+		{lang: "fr", key: "posted", value: now.Add(-2 * time.Hour), expect: "il y a 2 heures"},
+		{lang: "fr", key: "posted", value: now.Add(time.Hour), expect: "dans 1 heure"},
+		{lang: "pl", key: "posted", value: now.Add(-24 * time.Hour), expect: "1 dzień temu"},
+		{lang: "pl", key: "posted", value: now.Add(-3 * 24 * time.Hour), expect: "3 dni temu"},
+		{lang: "pl", key: "posted", value: 2 * time.Hour, expect: "za 2 godziny"},
+		{lang: "pl", key: "posted", value: 5 * time.Hour, expect: "za 5 godzin"},
+		{lang: "pl", key: "posted", value: -22 * time.Minute, expect: "22 minuty temu"},
+		{lang: "pl", key: "posted", value: -25 * time.Minute, expect: "25 minut temu"},
+		{lang: "pl", key: "posted", value: -12 * time.Minute, expect: "12 minut temu"},
+		{lang: "pl", key: "short", value: now.Add(-14 * 24 * time.Hour), expect: "2 tyg. temu"},
+		// Languages without relative time data use en.
+		{lang: "sv", key: "posted", value: now.Add(-5 * 24 * time.Hour), expect: "5 days ago"},
+	}
+
+	for _, tc := range cases {
+		ctx := WithNow(WithLanguage(context.Background(), tc.lang), now)
+		require.Equal(t, tc.expect, c.Message(ctx, tc.key, map[string]any{"at": tc.value}), "%s %v", tc.lang, tc.value)
+	}
+
+	raw := c.Raw()[LanguageID{Language: "en"}]
+	require.Equal(t, ":at|relative", raw["posted"])
+	require.Equal(t, ":at|relative(short)", raw["short"])
+}
+
+func TestContainerRelativeInvalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+posted: ":at|relative(narrow)"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.Error(t, err)
+}
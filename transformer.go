@@ -0,0 +1,158 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SLASH2NL/lingua/internal/parser"
+)
+
+// Transformer transforms the value of a replacement before it is inserted into the message, like `:name|capitalize`.
+type Transformer interface {
+	// Transform returns the transformed value. The value is the replacement value or the result of the previous transformer in the chain.
+	// The lang is the language of the message and replacements holds all replacements of the message.
+	Transform(ctx context.Context, lang LanguageID, value any, replacements map[string]any) any
+}
+
+// TransformerFunc is an adapter to use a function as a Transformer.
+type TransformerFunc func(ctx context.Context, lang LanguageID, value any, replacements map[string]any) any
+
+func (fn TransformerFunc) Transform(ctx context.Context, lang LanguageID, value any, replacements map[string]any) any {
+	return fn(ctx, lang, value, replacements)
+}
+
+// TransformerFactory creates a Transformer with the arguments between the parentheses, like 10 in `:name|truncate(10)`.
+// Quoted arguments are unquoted. The factory is called when the messages are loaded, so invalid arguments are reported
+// as an error by ContainerFromFs.
+type TransformerFactory func(args []string) (Transformer, error)
+
+// localeTransformer is implemented by the transformers that format with the locale data of the language, like date.
+// Languages without locale data use the data of en.
+type localeTransformer interface {
+	hasLocale(lang LanguageID) bool
+}
+
+// WithTransformer registers the transformer with the name. The name can only contain lowercase letters.
+// A registered transformer replaces the built-in transformer with the same name.
+// The plural, ordinal and select transformers are part of the message syntax and can not be replaced.
+// It panics if the name is invalid, because messages can never use the transformer.
+func WithTransformer(name string, factory TransformerFactory) ContainerOpt {
+	if name == "" || strings.IndexFunc(name, func(r rune) bool { return r < 'a' || r > 'z' }) != -1 {
+		panic(fmt.Sprintf("invalid transformer name %q, the name can only contain lowercase letters", name))
+	}
+
+	switch name {
+	case "plural", "ordinal", "select":
+		panic(fmt.Sprintf("transformer %q is part of the message syntax and can not be replaced", name))
+	}
+
+	return func(c *Container) {
+		c.transformers[name] = factory
+	}
+}
+
+// WithUnregisteredTransformers makes the container accept transformers that are not registered.
+// These transformers do not change the value. This is useful for tools that only read and write the raw messages,
+// like lingua extract, which do not know the custom transformers of an application.
+func WithUnregisteredTransformers() ContainerOpt {
+	return func(c *Container) {
+		c.unregisteredTransformers = true
+	}
+}
+
+// builtinTransformers returns the built-in transformers of the container.
+func (c *Container) builtinTransformers() map[string]TransformerFactory {
+	return map[string]TransformerFactory{
+		"capitalize": noArgs(capitalizeTransformer{}),
+		"upper":      noArgs(caseTransformer(upperCaser)),
+		"lower":      noArgs(caseTransformer(lowerCaser)),
+		"title":      noArgs(caseTransformer(titleCaser)),
+		"replace":    noArgs(replaceTransformer{c: c}),
+		"number":     newNumberTransformer,
+		"currency":   newCurrencyTransformer,
+		"date":       newDateTransformer(dateKindDate),
+		"time":       newDateTransformer(dateKindTime),
+		"datetime":   newDateTransformer(dateKindDateTime),
+		"relative":   newRelativeTransformer,
+		"list":       c.newListTransformer,
+		"default":    newDefaultTransformer,
+	}
+}
+
+// resolveTransformer returns the Transformer for the name and arguments of a transformer in a message.
+func (c *Container) resolveTransformer(name string, args []string) (any, error) {
+	factory, ok := c.transformers[name]
+	if !ok {
+		if c.unregisteredTransformers {
+			return TransformerFunc(func(_ context.Context, _ LanguageID, value any, _ map[string]any) any {
+				return value
+			}), nil
+		}
+
+		return nil, fmt.Errorf("unknown transformer %q", name)
+	}
+
+	return factory(args)
+}
+
+// noArgs returns a factory for a transformer that has no arguments.
+func noArgs(t Transformer) TransformerFactory {
+	return func(args []string) (Transformer, error) {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("unexpected arguments %q", args)
+		}
+
+		return t, nil
+	}
+}
+
+// replaceTransformer uses the value as the key of a message of the same language.
+type replaceTransformer struct {
+	c *Container
+}
+
+func (t replaceTransformer) Transform(_ context.Context, lang LanguageID, value any, _ map[string]any) any {
+	if _, rep, ok := t.c.lookup(lang, Key(formatReplacement(value))); ok {
+		// Only allow literals as replacements.
+		return rep.Raw()
+	}
+
+	return value
+}
+
+// defaultTransformer provides the value of a replacement that is missing or nil.
+type defaultTransformer struct {
+	value string
+}
+
+// newDefaultTransformer creates a default transformer with the default value, like `default("guest")`.
+func newDefaultTransformer(args []string) (Transformer, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected a single value for default transformer")
+	}
+
+	return defaultTransformer{value: args[0]}, nil
+}
+
+func (t defaultTransformer) Transform(_ context.Context, _ LanguageID, value any, _ map[string]any) any {
+	if value == nil {
+		return t.value
+	}
+
+	return value
+}
+
+// defaultValue returns the value of the default transformer in the transformers.
+// It returns false if there is no default transformer.
+func defaultValue(transformers []any) (string, bool) {
+	for _, transformer := range transformers {
+		if t, ok := transformer.(parser.Transformer); ok {
+			if d, ok := t.Impl.(defaultTransformer); ok {
+				return d.value, true
+			}
+		}
+	}
+
+	return "", false
+}
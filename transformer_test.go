@@ -0,0 +1,119 @@
+package lingua
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerCustomTransformer(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+card: "Card :card|mask(4)|capitalize"
+name: "Hello :name|capitalize|truncate(3)"
+`)
+
+	truncate := func(args []string) (Transformer, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected a length")
+		}
+
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid length %q: %w", args[0], err)
+		}
+
+		return TransformerFunc(func(_ context.Context, _ LanguageID, value any, _ map[string]any) any {
+			s := formatReplacement(value)
+			if len(s) > n {
+				return s[:n]
+			}
+
+			return s
+		}), nil
+	}
+
+	mask := func(args []string) (Transformer, error) {
+		visible, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return TransformerFunc(func(_ context.Context, _ LanguageID, value any, _ map[string]any) any {
+			s := formatReplacement(value)
+			return strings.Repeat("*", len(s)-visible) + s[len(s)-visible:]
+		}), nil
+	}
+
+	c, err := ContainerFromFs(fs, WithTransformer("truncate", truncate), WithTransformer("mask", mask))
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Card ****1234", c.Message(ctx, "card", map[string]any{"card": "56781234"}))
+	require.Equal(t, "Hello Joh", c.Message(ctx, "name", map[string]any{"name": "john"}))
+
+	raw := c.Raw()[LanguageID{Language: "en"}]
+	require.Equal(t, "Card :card|mask(4)|capitalize", raw["card"])
+}
+
+func TestContainerOverrideTransformer(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+welcome: "Welcome :name|capitalize"
+`)
+
+	upper := func(args []string) (Transformer, error) {
+		return TransformerFunc(func(_ context.Context, _ LanguageID, value any, _ map[string]any) any {
+			return strings.ToUpper(formatReplacement(value))
+		}), nil
+	}
+
+	c, err := ContainerFromFs(fs, WithTransformer("capitalize", upper))
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Welcome JOHN", c.Message(ctx, "welcome", map[string]any{"name": "john"}))
+}
+
+func TestContainerUnknownTransformer(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+name: "Hello :name|truncate(3)"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.ErrorContains(t, err, `unknown transformer "truncate"`)
+
+	c, err := ContainerFromFs(fs, WithUnregisteredTransformers())
+	require.NoError(t, err)
+
+	ctx := WithLanguage(context.Background(), "en")
+	require.Equal(t, "Hello john", c.Message(ctx, "name", map[string]any{"name": "john"}))
+	require.Equal(t, "Hello :name|truncate(3)", c.Raw()[LanguageID{Language: "en"}]["name"])
+}
+
+func TestContainerTransformerArgs(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	mustWriteYaml(t, fs, "en.yaml", `
+name: "Hello :name|capitalize(3)"
+`)
+
+	_, err := ContainerFromFs(fs)
+	require.Error(t, err)
+}
+
+func TestWithTransformerInvalidName(t *testing.T) {
+	upper := noArgs(caseTransformer(upperCaser))
+
+	require.PanicsWithValue(t, `invalid transformer name "my_up", the name can only contain lowercase letters`, func() { WithTransformer("my_up", upper) })
+	require.PanicsWithValue(t, `invalid transformer name "Upper", the name can only contain lowercase letters`, func() { WithTransformer("Upper", upper) })
+	require.PanicsWithValue(t, `invalid transformer name "", the name can only contain lowercase letters`, func() { WithTransformer("", upper) })
+	require.PanicsWithValue(t, `transformer "plural" is part of the message syntax and can not be replaced`, func() { WithTransformer("plural", upper) })
+	require.PanicsWithValue(t, `transformer "select" is part of the message syntax and can not be replaced`, func() { WithTransformer("select", upper) })
+	require.NotPanics(t, func() { WithTransformer("shout", upper) })
+}